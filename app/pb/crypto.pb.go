@@ -9,6 +9,7 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,6 +21,503 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type RankingStrategy int32
+
+const (
+	// RANKING_STRATEGY_UNSPECIFIED behaves as NET_SCORE.
+	RankingStrategy_RANKING_STRATEGY_UNSPECIFIED RankingStrategy = 0
+	// NET_SCORE is likes minus dislikes.
+	RankingStrategy_NET_SCORE RankingStrategy = 1
+	// RATIO is the share of likes among all votes.
+	RankingStrategy_RATIO RankingStrategy = 2
+	// WILSON is the lower bound of the 95% Wilson interval of the like ratio.
+	RankingStrategy_WILSON RankingStrategy = 3
+	// BAYESIAN is the like ratio averaged with ten votes evenly split.
+	RankingStrategy_BAYESIAN RankingStrategy = 4
+	// HOT favors net votes that were cast recently.
+	RankingStrategy_HOT RankingStrategy = 5
+	// CONTROVERSIAL favors many votes evenly split between likes and dislikes.
+	RankingStrategy_CONTROVERSIAL RankingStrategy = 6
+)
+
+// Enum value maps for RankingStrategy.
+var (
+	RankingStrategy_name = map[int32]string{
+		0: "RANKING_STRATEGY_UNSPECIFIED",
+		1: "NET_SCORE",
+		2: "RATIO",
+		3: "WILSON",
+		4: "BAYESIAN",
+		5: "HOT",
+		6: "CONTROVERSIAL",
+	}
+	RankingStrategy_value = map[string]int32{
+		"RANKING_STRATEGY_UNSPECIFIED": 0,
+		"NET_SCORE":                    1,
+		"RATIO":                        2,
+		"WILSON":                       3,
+		"BAYESIAN":                     4,
+		"HOT":                          5,
+		"CONTROVERSIAL":                6,
+	}
+)
+
+func (x RankingStrategy) Enum() *RankingStrategy {
+	p := new(RankingStrategy)
+	*p = x
+	return p
+}
+
+func (x RankingStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RankingStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_crypto_proto_enumTypes[0].Descriptor()
+}
+
+func (RankingStrategy) Type() protoreflect.EnumType {
+	return &file_crypto_proto_enumTypes[0]
+}
+
+func (x RankingStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RankingStrategy.Descriptor instead.
+func (RankingStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{0}
+}
+
+type HistoryResolution int32
+
+const (
+	// HISTORY_RESOLUTION_UNSPECIFIED uses the snapshot bucket of the server.
+	HistoryResolution_HISTORY_RESOLUTION_UNSPECIFIED HistoryResolution = 0
+	HistoryResolution_MINUTE                         HistoryResolution = 1
+	HistoryResolution_HOUR                           HistoryResolution = 2
+	HistoryResolution_DAY                            HistoryResolution = 3
+	HistoryResolution_WEEK                           HistoryResolution = 4
+)
+
+// Enum value maps for HistoryResolution.
+var (
+	HistoryResolution_name = map[int32]string{
+		0: "HISTORY_RESOLUTION_UNSPECIFIED",
+		1: "MINUTE",
+		2: "HOUR",
+		3: "DAY",
+		4: "WEEK",
+	}
+	HistoryResolution_value = map[string]int32{
+		"HISTORY_RESOLUTION_UNSPECIFIED": 0,
+		"MINUTE":                         1,
+		"HOUR":                           2,
+		"DAY":                            3,
+		"WEEK":                           4,
+	}
+)
+
+func (x HistoryResolution) Enum() *HistoryResolution {
+	p := new(HistoryResolution)
+	*p = x
+	return p
+}
+
+func (x HistoryResolution) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HistoryResolution) Descriptor() protoreflect.EnumDescriptor {
+	return file_crypto_proto_enumTypes[1].Descriptor()
+}
+
+func (HistoryResolution) Type() protoreflect.EnumType {
+	return &file_crypto_proto_enumTypes[1]
+}
+
+func (x HistoryResolution) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HistoryResolution.Descriptor instead.
+func (HistoryResolution) EnumDescriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{1}
+}
+
+type VoteDirection int32
+
+const (
+	VoteDirection_VOTE_DIRECTION_UNSPECIFIED VoteDirection = 0
+	VoteDirection_LIKE                       VoteDirection = 1
+	VoteDirection_DISLIKE                    VoteDirection = 2
+	VoteDirection_REMOVE_LIKE                VoteDirection = 3
+	VoteDirection_REMOVE_DISLIKE             VoteDirection = 4
+)
+
+// Enum value maps for VoteDirection.
+var (
+	VoteDirection_name = map[int32]string{
+		0: "VOTE_DIRECTION_UNSPECIFIED",
+		1: "LIKE",
+		2: "DISLIKE",
+		3: "REMOVE_LIKE",
+		4: "REMOVE_DISLIKE",
+	}
+	VoteDirection_value = map[string]int32{
+		"VOTE_DIRECTION_UNSPECIFIED": 0,
+		"LIKE":                       1,
+		"DISLIKE":                    2,
+		"REMOVE_LIKE":                3,
+		"REMOVE_DISLIKE":             4,
+	}
+)
+
+func (x VoteDirection) Enum() *VoteDirection {
+	p := new(VoteDirection)
+	*p = x
+	return p
+}
+
+func (x VoteDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VoteDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_crypto_proto_enumTypes[2].Descriptor()
+}
+
+func (VoteDirection) Type() protoreflect.EnumType {
+	return &file_crypto_proto_enumTypes[2]
+}
+
+func (x VoteDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VoteDirection.Descriptor instead.
+func (VoteDirection) EnumDescriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{2}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	ExportFormat_CSV                       ExportFormat = 1
+	ExportFormat_JSON                      ExportFormat = 2
+	ExportFormat_NDJSON                    ExportFormat = 3
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "CSV",
+		2: "JSON",
+		3: "NDJSON",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"CSV":                       1,
+		"JSON":                      2,
+		"NDJSON":                    3,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_crypto_proto_enumTypes[3].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_crypto_proto_enumTypes[3]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{3}
+}
+
+type SearchMode int32
+
+const (
+	// SEARCH_MODE_UNSPECIFIED behaves as FULL_TEXT.
+	SearchMode_SEARCH_MODE_UNSPECIFIED SearchMode = 0
+	// PREFIX matches the start of the name, symbol or slug.
+	SearchMode_PREFIX SearchMode = 1
+	// FULL_TEXT matches words of the name, symbol or description.
+	SearchMode_FULL_TEXT SearchMode = 2
+	// FUZZY is FULL_TEXT tolerating typos.
+	SearchMode_FUZZY SearchMode = 3
+)
+
+// Enum value maps for SearchMode.
+var (
+	SearchMode_name = map[int32]string{
+		0: "SEARCH_MODE_UNSPECIFIED",
+		1: "PREFIX",
+		2: "FULL_TEXT",
+		3: "FUZZY",
+	}
+	SearchMode_value = map[string]int32{
+		"SEARCH_MODE_UNSPECIFIED": 0,
+		"PREFIX":                  1,
+		"FULL_TEXT":               2,
+		"FUZZY":                   3,
+	}
+)
+
+func (x SearchMode) Enum() *SearchMode {
+	p := new(SearchMode)
+	*p = x
+	return p
+}
+
+func (x SearchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_crypto_proto_enumTypes[4].Descriptor()
+}
+
+func (SearchMode) Type() protoreflect.EnumType {
+	return &file_crypto_proto_enumTypes[4]
+}
+
+func (x SearchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchMode.Descriptor instead.
+func (SearchMode) EnumDescriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{4}
+}
+
+type TrendingWindow int32
+
+const (
+	// TRENDING_WINDOW_UNSPECIFIED behaves as LAST_DAY.
+	TrendingWindow_TRENDING_WINDOW_UNSPECIFIED TrendingWindow = 0
+	TrendingWindow_LAST_HOUR                   TrendingWindow = 1
+	TrendingWindow_LAST_DAY                    TrendingWindow = 2
+	TrendingWindow_LAST_WEEK                   TrendingWindow = 3
+)
+
+// Enum value maps for TrendingWindow.
+var (
+	TrendingWindow_name = map[int32]string{
+		0: "TRENDING_WINDOW_UNSPECIFIED",
+		1: "LAST_HOUR",
+		2: "LAST_DAY",
+		3: "LAST_WEEK",
+	}
+	TrendingWindow_value = map[string]int32{
+		"TRENDING_WINDOW_UNSPECIFIED": 0,
+		"LAST_HOUR":                   1,
+		"LAST_DAY":                    2,
+		"LAST_WEEK":                   3,
+	}
+)
+
+func (x TrendingWindow) Enum() *TrendingWindow {
+	p := new(TrendingWindow)
+	*p = x
+	return p
+}
+
+func (x TrendingWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrendingWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_crypto_proto_enumTypes[5].Descriptor()
+}
+
+func (TrendingWindow) Type() protoreflect.EnumType {
+	return &file_crypto_proto_enumTypes[5]
+}
+
+func (x TrendingWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrendingWindow.Descriptor instead.
+func (TrendingWindow) EnumDescriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{5}
+}
+
+type SignatureScheme int32
+
+const (
+	SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED SignatureScheme = 0
+	SignatureScheme_ED25519                      SignatureScheme = 1
+	SignatureScheme_SECP256K1                    SignatureScheme = 2
+)
+
+// Enum value maps for SignatureScheme.
+var (
+	SignatureScheme_name = map[int32]string{
+		0: "SIGNATURE_SCHEME_UNSPECIFIED",
+		1: "ED25519",
+		2: "SECP256K1",
+	}
+	SignatureScheme_value = map[string]int32{
+		"SIGNATURE_SCHEME_UNSPECIFIED": 0,
+		"ED25519":                      1,
+		"SECP256K1":                    2,
+	}
+)
+
+func (x SignatureScheme) Enum() *SignatureScheme {
+	p := new(SignatureScheme)
+	*p = x
+	return p
+}
+
+func (x SignatureScheme) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SignatureScheme) Descriptor() protoreflect.EnumDescriptor {
+	return file_crypto_proto_enumTypes[6].Descriptor()
+}
+
+func (SignatureScheme) Type() protoreflect.EnumType {
+	return &file_crypto_proto_enumTypes[6]
+}
+
+func (x SignatureScheme) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SignatureScheme.Descriptor instead.
+func (SignatureScheme) EnumDescriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{6}
+}
+
+type FraudAction int32
+
+const (
+	FraudAction_FRAUD_ACTION_UNSPECIFIED FraudAction = 0
+	// FLAG counts the vote and keeps it for review.
+	FraudAction_FLAG FraudAction = 1
+	// DISCOUNT does not count the vote until it is approved.
+	FraudAction_DISCOUNT FraudAction = 2
+	// QUARANTINE discounts the vote and, for a while, every vote from its
+	// network and wallet.
+	FraudAction_QUARANTINE FraudAction = 3
+)
+
+// Enum value maps for FraudAction.
+var (
+	FraudAction_name = map[int32]string{
+		0: "FRAUD_ACTION_UNSPECIFIED",
+		1: "FLAG",
+		2: "DISCOUNT",
+		3: "QUARANTINE",
+	}
+	FraudAction_value = map[string]int32{
+		"FRAUD_ACTION_UNSPECIFIED": 0,
+		"FLAG":                     1,
+		"DISCOUNT":                 2,
+		"QUARANTINE":               3,
+	}
+)
+
+func (x FraudAction) Enum() *FraudAction {
+	p := new(FraudAction)
+	*p = x
+	return p
+}
+
+func (x FraudAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FraudAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_crypto_proto_enumTypes[7].Descriptor()
+}
+
+func (FraudAction) Type() protoreflect.EnumType {
+	return &file_crypto_proto_enumTypes[7]
+}
+
+func (x FraudAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FraudAction.Descriptor instead.
+func (FraudAction) EnumDescriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{7}
+}
+
+type FraudCaseStatus int32
+
+const (
+	FraudCaseStatus_FRAUD_CASE_STATUS_UNSPECIFIED FraudCaseStatus = 0
+	FraudCaseStatus_OPEN                          FraudCaseStatus = 1
+	FraudCaseStatus_APPROVED                      FraudCaseStatus = 2
+	FraudCaseStatus_REJECTED                      FraudCaseStatus = 3
+)
+
+// Enum value maps for FraudCaseStatus.
+var (
+	FraudCaseStatus_name = map[int32]string{
+		0: "FRAUD_CASE_STATUS_UNSPECIFIED",
+		1: "OPEN",
+		2: "APPROVED",
+		3: "REJECTED",
+	}
+	FraudCaseStatus_value = map[string]int32{
+		"FRAUD_CASE_STATUS_UNSPECIFIED": 0,
+		"OPEN":                          1,
+		"APPROVED":                      2,
+		"REJECTED":                      3,
+	}
+)
+
+func (x FraudCaseStatus) Enum() *FraudCaseStatus {
+	p := new(FraudCaseStatus)
+	*p = x
+	return p
+}
+
+func (x FraudCaseStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FraudCaseStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_crypto_proto_enumTypes[8].Descriptor()
+}
+
+func (FraudCaseStatus) Type() protoreflect.EnumType {
+	return &file_crypto_proto_enumTypes[8]
+}
+
+func (x FraudCaseStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FraudCaseStatus.Descriptor instead.
+func (FraudCaseStatus) EnumDescriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{8}
+}
+
 type Crypto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -30,6 +528,8 @@ type Crypto struct {
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	Likes       int64  `protobuf:"varint,4,opt,name=likes,proto3" json:"likes,omitempty"`
 	Dislikes    int64  `protobuf:"varint,5,opt,name=dislikes,proto3" json:"dislikes,omitempty"`
+	Symbol      string `protobuf:"bytes,6,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Slug        string `protobuf:"bytes,7,opt,name=slug,proto3" json:"slug,omitempty"`
 }
 
 func (x *Crypto) Reset() {
@@ -99,6 +599,20 @@ func (x *Crypto) GetDislikes() int64 {
 	return 0
 }
 
+func (x *Crypto) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Crypto) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
 type CreateCryptoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -106,6 +620,8 @@ type CreateCryptoRequest struct {
 
 	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Symbol      string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Slug        string `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
 }
 
 func (x *CreateCryptoRequest) Reset() {
@@ -154,6 +670,20 @@ func (x *CreateCryptoRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateCryptoRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CreateCryptoRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
 type CreateCryptoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -213,6 +743,8 @@ type ListCryptosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Ranking RankingStrategy `protobuf:"varint,1,opt,name=ranking,proto3,enum=crypto.RankingStrategy" json:"ranking,omitempty"`
 }
 
 func (x *ListCryptosRequest) Reset() {
@@ -247,12 +779,21 @@ func (*ListCryptosRequest) Descriptor() ([]byte, []int) {
 	return file_crypto_proto_rawDescGZIP(), []int{3}
 }
 
+func (x *ListCryptosRequest) GetRanking() RankingStrategy {
+	if x != nil {
+		return x.Ranking
+	}
+	return RankingStrategy_RANKING_STRATEGY_UNSPECIFIED
+}
+
 type ListCryptosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Crypto *Crypto `protobuf:"bytes,1,opt,name=crypto,proto3" json:"crypto,omitempty"`
+	// score is the value the cryptos are sorted by.
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
 }
 
 func (x *ListCryptosResponse) Reset() {
@@ -294,6 +835,13 @@ func (x *ListCryptosResponse) GetCrypto() *Crypto {
 	return nil
 }
 
+func (x *ListCryptosResponse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
 type ReadCryptoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -396,6 +944,8 @@ type UpdateCryptoRequest struct {
 	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Symbol      string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Slug        string `protobuf:"bytes,5,opt,name=slug,proto3" json:"slug,omitempty"`
 }
 
 func (x *UpdateCryptoRequest) Reset() {
@@ -451,6 +1001,20 @@ func (x *UpdateCryptoRequest) GetDescription() string {
 	return ""
 }
 
+func (x *UpdateCryptoRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *UpdateCryptoRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
 type UpdateCryptoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1030,6 +1594,8 @@ type CountVotesResponse struct {
 
 	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Total int64  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// tally is the latest published tally of the wallet votes, if any.
+	Tally *VoteTally `protobuf:"bytes,3,opt,name=tally,proto3" json:"tally,omitempty"`
 }
 
 func (x *CountVotesResponse) Reset() {
@@ -1078,16 +1644,32 @@ func (x *CountVotesResponse) GetTotal() int64 {
 	return 0
 }
 
-type FilterByNameRequest struct {
+func (x *CountVotesResponse) GetTally() *VoteTally {
+	if x != nil {
+		return x.Tally
+	}
+	return nil
+}
+
+// VoteTally counts the wallet votes on a crypto. root is the root of a
+// Merkle tree over those votes, against which GetVoteProof proofs verify.
+// It covers signed wallet votes only: anonymous votes are not part of it, so
+// likes and dislikes here need not add up to the CountVotes total.
+type VoteTally struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version  int64                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Root     []byte                 `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	Likes    int64                  `protobuf:"varint,3,opt,name=likes,proto3" json:"likes,omitempty"`
+	Dislikes int64                  `protobuf:"varint,4,opt,name=dislikes,proto3" json:"dislikes,omitempty"`
+	Voters   int64                  `protobuf:"varint,5,opt,name=voters,proto3" json:"voters,omitempty"`
+	BuiltAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=built_at,json=builtAt,proto3" json:"built_at,omitempty"`
 }
 
-func (x *FilterByNameRequest) Reset() {
-	*x = FilterByNameRequest{}
+func (x *VoteTally) Reset() {
+	*x = VoteTally{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_crypto_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1095,13 +1677,13 @@ func (x *FilterByNameRequest) Reset() {
 	}
 }
 
-func (x *FilterByNameRequest) String() string {
+func (x *VoteTally) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilterByNameRequest) ProtoMessage() {}
+func (*VoteTally) ProtoMessage() {}
 
-func (x *FilterByNameRequest) ProtoReflect() protoreflect.Message {
+func (x *VoteTally) ProtoReflect() protoreflect.Message {
 	mi := &file_crypto_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1113,28 +1695,64 @@ func (x *FilterByNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilterByNameRequest.ProtoReflect.Descriptor instead.
-func (*FilterByNameRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VoteTally.ProtoReflect.Descriptor instead.
+func (*VoteTally) Descriptor() ([]byte, []int) {
 	return file_crypto_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *FilterByNameRequest) GetName() string {
+func (x *VoteTally) GetVersion() int64 {
 	if x != nil {
-		return x.Name
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-type FilterByNameResponse struct {
+func (x *VoteTally) GetRoot() []byte {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *VoteTally) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *VoteTally) GetDislikes() int64 {
+	if x != nil {
+		return x.Dislikes
+	}
+	return 0
+}
+
+func (x *VoteTally) GetVoters() int64 {
+	if x != nil {
+		return x.Voters
+	}
+	return 0
+}
+
+func (x *VoteTally) GetBuiltAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BuiltAt
+	}
+	return nil
+}
+
+type GetVoteProofRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Crypto *Crypto `protobuf:"bytes,1,opt,name=crypto,proto3" json:"crypto,omitempty"`
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *FilterByNameResponse) Reset() {
-	*x = FilterByNameResponse{}
+func (x *GetVoteProofRequest) Reset() {
+	*x = GetVoteProofRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_crypto_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1142,263 +1760,5433 @@ func (x *FilterByNameResponse) Reset() {
 	}
 }
 
-func (x *FilterByNameResponse) String() string {
+func (x *GetVoteProofRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilterByNameResponse) ProtoMessage() {}
+func (*GetVoteProofRequest) ProtoMessage() {}
 
-func (x *FilterByNameResponse) ProtoReflect() protoreflect.Message {
+func (x *GetVoteProofRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_crypto_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use FilterByNameResponse.ProtoReflect.Descriptor instead.
-func (*FilterByNameResponse) Descriptor() ([]byte, []int) {
-	return file_crypto_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *FilterByNameResponse) GetCrypto() *Crypto {
-	if x != nil {
-		return x.Crypto
-	}
-	return nil
-}
-
-var File_crypto_proto protoreflect.FileDescriptor
-
-var file_crypto_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x22, 0x80, 0x01, 0x0a, 0x06, 0x43, 0x72, 0x79, 0x70, 0x74,
-	0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x1a, 0x0a,
-	0x08, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x13, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x58, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72,
-	0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
-	0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x06, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x22, 0x23, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x43, 0x72, 0x79,
-	0x70, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3c, 0x0a, 0x12, 0x52, 0x65,
-	0x61, 0x64, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x26, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x22, 0x5b, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x58, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x22,
-	0x25, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x20, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x4c,
-	0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x39, 0x0a, 0x0f, 0x41, 0x64,
-	0x64, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
-	0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x06, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x22, 0x23, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c,
-	0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3c, 0x0a, 0x12, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x26, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x22, 0x23, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x44,
-	0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3c, 0x0a,
-	0x12, 0x41, 0x64, 0x64, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79,
-	0x70, 0x74, 0x6f, 0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x22, 0x26, 0x0a, 0x14, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x3f, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73,
-	0x6c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x06, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x6f, 0x22, 0x23, 0x0a, 0x11, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x6f, 0x74,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x29, 0x0a, 0x13, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x14, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x79,
-	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x06, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x6f, 0x32, 0x97, 0x06, 0x0a, 0x0d, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x43, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x12,
-	0x19, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x72, 0x79,
-	0x70, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72,
-	0x79, 0x70, 0x74, 0x6f, 0x73, 0x12, 0x1a, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01,
-	0x12, 0x49, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x12, 0x1b, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x79,
-	0x70, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x12, 0x1b, 0x2e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b,
-	0x65, 0x12, 0x16, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x69,
-	0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x6b, 0x65,
-	0x12, 0x19, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x44, 0x69,
-	0x73, 0x6c, 0x69, 0x6b, 0x65, 0x12, 0x19, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x41,
-	0x64, 0x64, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x69, 0x73,
-	0x6c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x12, 0x1c, 0x2e,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73,
-	0x6c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x6c, 0x69,
-	0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x6f, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3d, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x1b, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42,
-	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x30, 0x01, 0x42, 0x08,
-	0x5a, 0x06, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_crypto_proto_rawDescOnce sync.Once
-	file_crypto_proto_rawDescData = file_crypto_proto_rawDesc
-)
-
-func file_crypto_proto_rawDescGZIP() []byte {
-	file_crypto_proto_rawDescOnce.Do(func() {
-		file_crypto_proto_rawDescData = protoimpl.X.CompressGZIP(file_crypto_proto_rawDescData)
-	})
-	return file_crypto_proto_rawDescData
-}
-
-var file_crypto_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
-var file_crypto_proto_goTypes = []interface{}{
-	(*Crypto)(nil),                // 0: crypto.Crypto
-	(*CreateCryptoRequest)(nil),   // 1: crypto.CreateCryptoRequest
-	(*CreateCryptoResponse)(nil),  // 2: crypto.CreateCryptoResponse
-	(*ListCryptosRequest)(nil),    // 3: crypto.ListCryptosRequest
-	(*ListCryptosResponse)(nil),   // 4: crypto.ListCryptosResponse
-	(*ReadCryptoRequest)(nil),     // 5: crypto.ReadCryptoRequest
-	(*ReadCryptoResponse)(nil),    // 6: crypto.ReadCryptoResponse
-	(*UpdateCryptoRequest)(nil),   // 7: crypto.UpdateCryptoRequest
-	(*UpdateCryptoResponse)(nil),  // 8: crypto.UpdateCryptoResponse
-	(*DeleteCryptoRequest)(nil),   // 9: crypto.DeleteCryptoRequest
-	(*DeleteCryptoResponse)(nil),  // 10: crypto.DeleteCryptoResponse
-	(*AddLikeRequest)(nil),        // 11: crypto.AddLikeRequest
-	(*AddLikeResponse)(nil),       // 12: crypto.AddLikeResponse
-	(*RemoveLikeRequest)(nil),     // 13: crypto.RemoveLikeRequest
-	(*RemoveLikeResponse)(nil),    // 14: crypto.RemoveLikeResponse
-	(*AddDislikeRequest)(nil),     // 15: crypto.AddDislikeRequest
-	(*AddDislikeResponse)(nil),    // 16: crypto.AddDislikeResponse
-	(*RemoveDislikeRequest)(nil),  // 17: crypto.RemoveDislikeRequest
-	(*RemoveDislikeResponse)(nil), // 18: crypto.RemoveDislikeResponse
-	(*CountVotesRequest)(nil),     // 19: crypto.CountVotesRequest
-	(*CountVotesResponse)(nil),    // 20: crypto.CountVotesResponse
-	(*FilterByNameRequest)(nil),   // 21: crypto.FilterByNameRequest
-	(*FilterByNameResponse)(nil),  // 22: crypto.FilterByNameResponse
-}
-var file_crypto_proto_depIdxs = []int32{
-	0,  // 0: crypto.CreateCryptoResponse.crypto:type_name -> crypto.Crypto
-	0,  // 1: crypto.ListCryptosResponse.crypto:type_name -> crypto.Crypto
-	0,  // 2: crypto.ReadCryptoResponse.crypto:type_name -> crypto.Crypto
-	0,  // 3: crypto.UpdateCryptoResponse.crypto:type_name -> crypto.Crypto
-	0,  // 4: crypto.AddLikeResponse.crypto:type_name -> crypto.Crypto
-	0,  // 5: crypto.RemoveLikeResponse.crypto:type_name -> crypto.Crypto
-	0,  // 6: crypto.AddDislikeResponse.crypto:type_name -> crypto.Crypto
-	0,  // 7: crypto.RemoveDislikeResponse.crypto:type_name -> crypto.Crypto
-	0,  // 8: crypto.FilterByNameResponse.crypto:type_name -> crypto.Crypto
-	1,  // 9: crypto.CryptoService.CreateCrypto:input_type -> crypto.CreateCryptoRequest
-	5,  // 10: crypto.CryptoService.ReadCrypto:input_type -> crypto.ReadCryptoRequest
-	3,  // 11: crypto.CryptoService.ListCryptos:input_type -> crypto.ListCryptosRequest
-	7,  // 12: crypto.CryptoService.UpdateCrypto:input_type -> crypto.UpdateCryptoRequest
-	9,  // 13: crypto.CryptoService.DeleteCrypto:input_type -> crypto.DeleteCryptoRequest
-	11, // 14: crypto.CryptoService.AddLike:input_type -> crypto.AddLikeRequest
-	13, // 15: crypto.CryptoService.RemoveLike:input_type -> crypto.RemoveLikeRequest
-	15, // 16: crypto.CryptoService.AddDislike:input_type -> crypto.AddDislikeRequest
-	17, // 17: crypto.CryptoService.RemoveDislike:input_type -> crypto.RemoveDislikeRequest
-	19, // 18: crypto.CryptoService.CountVotes:input_type -> crypto.CountVotesRequest
-	21, // 19: crypto.CryptoService.FilterByName:input_type -> crypto.FilterByNameRequest
-	2,  // 20: crypto.CryptoService.CreateCrypto:output_type -> crypto.CreateCryptoResponse
-	6,  // 21: crypto.CryptoService.ReadCrypto:output_type -> crypto.ReadCryptoResponse
-	4,  // 22: crypto.CryptoService.ListCryptos:output_type -> crypto.ListCryptosResponse
-	8,  // 23: crypto.CryptoService.UpdateCrypto:output_type -> crypto.UpdateCryptoResponse
-	10, // 24: crypto.CryptoService.DeleteCrypto:output_type -> crypto.DeleteCryptoResponse
-	12, // 25: crypto.CryptoService.AddLike:output_type -> crypto.AddLikeResponse
-	14, // 26: crypto.CryptoService.RemoveLike:output_type -> crypto.RemoveLikeResponse
-	16, // 27: crypto.CryptoService.AddDislike:output_type -> crypto.AddDislikeResponse
-	18, // 28: crypto.CryptoService.RemoveDislike:output_type -> crypto.RemoveDislikeResponse
-	20, // 29: crypto.CryptoService.CountVotes:output_type -> crypto.CountVotesResponse
-	0,  // 30: crypto.CryptoService.FilterByName:output_type -> crypto.Crypto
-	20, // [20:31] is the sub-list for method output_type
-	9,  // [9:20] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
-}
-
-func init() { file_crypto_proto_init() }
-func file_crypto_proto_init() {
-	if File_crypto_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_crypto_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Crypto); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVoteProofRequest.ProtoReflect.Descriptor instead.
+func (*GetVoteProofRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetVoteProofRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetVoteProofRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// GetVoteProofResponse proves the vote of a wallet against the latest tally.
+type GetVoteProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tally *VoteTally `protobuf:"bytes,1,opt,name=tally,proto3" json:"tally,omitempty"`
+	// the vote as counted in the tally.
+	Liked    bool `protobuf:"varint,2,opt,name=liked,proto3" json:"liked,omitempty"`
+	Disliked bool `protobuf:"varint,3,opt,name=disliked,proto3" json:"disliked,omitempty"`
+	// leaf is the hash of the vote, and proof the sibling hashes from the
+	// leaf up to the root.
+	Leaf  []byte       `protobuf:"bytes,4,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	Proof []*ProofStep `protobuf:"bytes,5,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (x *GetVoteProofResponse) Reset() {
+	*x = GetVoteProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVoteProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVoteProofResponse) ProtoMessage() {}
+
+func (x *GetVoteProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVoteProofResponse.ProtoReflect.Descriptor instead.
+func (*GetVoteProofResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetVoteProofResponse) GetTally() *VoteTally {
+	if x != nil {
+		return x.Tally
+	}
+	return nil
+}
+
+func (x *GetVoteProofResponse) GetLiked() bool {
+	if x != nil {
+		return x.Liked
+	}
+	return false
+}
+
+func (x *GetVoteProofResponse) GetDisliked() bool {
+	if x != nil {
+		return x.Disliked
+	}
+	return false
+}
+
+func (x *GetVoteProofResponse) GetLeaf() []byte {
+	if x != nil {
+		return x.Leaf
+	}
+	return nil
+}
+
+func (x *GetVoteProofResponse) GetProof() []*ProofStep {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+type ProofStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// left is true when the sibling is on the left.
+	Left bool `protobuf:"varint,2,opt,name=left,proto3" json:"left,omitempty"`
+}
+
+func (x *ProofStep) Reset() {
+	*x = ProofStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofStep) ProtoMessage() {}
+
+func (x *ProofStep) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofStep.ProtoReflect.Descriptor instead.
+func (*ProofStep) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ProofStep) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *ProofStep) GetLeft() bool {
+	if x != nil {
+		return x.Left
+	}
+	return false
+}
+
+type GetCryptoStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetCryptoStatsRequest) Reset() {
+	*x = GetCryptoStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCryptoStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCryptoStatsRequest) ProtoMessage() {}
+
+func (x *GetCryptoStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCryptoStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCryptoStatsRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetCryptoStatsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCryptoStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Crypto   *Crypto `protobuf:"bytes,1,opt,name=crypto,proto3" json:"crypto,omitempty"`
+	Likes    int64   `protobuf:"varint,2,opt,name=likes,proto3" json:"likes,omitempty"`
+	Dislikes int64   `protobuf:"varint,3,opt,name=dislikes,proto3" json:"dislikes,omitempty"`
+	Total    int64   `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	// ratio is the share of likes among all votes, 0 without votes.
+	Ratio float64 `protobuf:"fixed64,5,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	// controversy grows with the number of votes and how evenly they are split.
+	Controversy float64 `protobuf:"fixed64,6,opt,name=controversy,proto3" json:"controversy,omitempty"`
+	// percentile is the share of cryptos with a lower net score, ties counting
+	// as half, from 0 to 100.
+	Percentile float64 `protobuf:"fixed64,7,opt,name=percentile,proto3" json:"percentile,omitempty"`
+}
+
+func (x *GetCryptoStatsResponse) Reset() {
+	*x = GetCryptoStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCryptoStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCryptoStatsResponse) ProtoMessage() {}
+
+func (x *GetCryptoStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCryptoStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCryptoStatsResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetCryptoStatsResponse) GetCrypto() *Crypto {
+	if x != nil {
+		return x.Crypto
+	}
+	return nil
+}
+
+func (x *GetCryptoStatsResponse) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *GetCryptoStatsResponse) GetDislikes() int64 {
+	if x != nil {
+		return x.Dislikes
+	}
+	return 0
+}
+
+func (x *GetCryptoStatsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetCryptoStatsResponse) GetRatio() float64 {
+	if x != nil {
+		return x.Ratio
+	}
+	return 0
+}
+
+func (x *GetCryptoStatsResponse) GetControversy() float64 {
+	if x != nil {
+		return x.Controversy
+	}
+	return 0
+}
+
+func (x *GetCryptoStatsResponse) GetPercentile() float64 {
+	if x != nil {
+		return x.Percentile
+	}
+	return 0
+}
+
+type GetCatalogStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetCatalogStatsRequest) Reset() {
+	*x = GetCatalogStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCatalogStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCatalogStatsRequest) ProtoMessage() {}
+
+func (x *GetCatalogStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCatalogStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCatalogStatsRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{27}
+}
+
+type GetCatalogStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cryptos       int64 `protobuf:"varint,1,opt,name=cryptos,proto3" json:"cryptos,omitempty"`
+	TotalLikes    int64 `protobuf:"varint,2,opt,name=total_likes,json=totalLikes,proto3" json:"total_likes,omitempty"`
+	TotalDislikes int64 `protobuf:"varint,3,opt,name=total_dislikes,json=totalDislikes,proto3" json:"total_dislikes,omitempty"`
+	// average_ratio is the mean like ratio of the cryptos that have votes.
+	AverageRatio float64 `protobuf:"fixed64,4,opt,name=average_ratio,json=averageRatio,proto3" json:"average_ratio,omitempty"`
+	// positive, negative and neutral count cryptos by the sign of their net score.
+	Positive int64 `protobuf:"varint,5,opt,name=positive,proto3" json:"positive,omitempty"`
+	Negative int64 `protobuf:"varint,6,opt,name=negative,proto3" json:"negative,omitempty"`
+	Neutral  int64 `protobuf:"varint,7,opt,name=neutral,proto3" json:"neutral,omitempty"`
+	// ratio_distribution splits the cryptos that have votes by like ratio, in
+	// tenths.
+	RatioDistribution []*HistogramBucket `protobuf:"bytes,8,rep,name=ratio_distribution,json=ratioDistribution,proto3" json:"ratio_distribution,omitempty"`
+	// vote_distribution splits the cryptos by total votes, in powers of ten.
+	VoteDistribution []*HistogramBucket `protobuf:"bytes,9,rep,name=vote_distribution,json=voteDistribution,proto3" json:"vote_distribution,omitempty"`
+}
+
+func (x *GetCatalogStatsResponse) Reset() {
+	*x = GetCatalogStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCatalogStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCatalogStatsResponse) ProtoMessage() {}
+
+func (x *GetCatalogStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCatalogStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCatalogStatsResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetCatalogStatsResponse) GetCryptos() int64 {
+	if x != nil {
+		return x.Cryptos
+	}
+	return 0
+}
+
+func (x *GetCatalogStatsResponse) GetTotalLikes() int64 {
+	if x != nil {
+		return x.TotalLikes
+	}
+	return 0
+}
+
+func (x *GetCatalogStatsResponse) GetTotalDislikes() int64 {
+	if x != nil {
+		return x.TotalDislikes
+	}
+	return 0
+}
+
+func (x *GetCatalogStatsResponse) GetAverageRatio() float64 {
+	if x != nil {
+		return x.AverageRatio
+	}
+	return 0
+}
+
+func (x *GetCatalogStatsResponse) GetPositive() int64 {
+	if x != nil {
+		return x.Positive
+	}
+	return 0
+}
+
+func (x *GetCatalogStatsResponse) GetNegative() int64 {
+	if x != nil {
+		return x.Negative
+	}
+	return 0
+}
+
+func (x *GetCatalogStatsResponse) GetNeutral() int64 {
+	if x != nil {
+		return x.Neutral
+	}
+	return 0
+}
+
+func (x *GetCatalogStatsResponse) GetRatioDistribution() []*HistogramBucket {
+	if x != nil {
+		return x.RatioDistribution
+	}
+	return nil
+}
+
+func (x *GetCatalogStatsResponse) GetVoteDistribution() []*HistogramBucket {
+	if x != nil {
+		return x.VoteDistribution
+	}
+	return nil
+}
+
+// HistogramBucket counts the values in [lower, upper); the last bucket of a
+// distribution may be unbounded, in which case upper is not set.
+type HistogramBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lower float64  `protobuf:"fixed64,1,opt,name=lower,proto3" json:"lower,omitempty"`
+	Upper *float64 `protobuf:"fixed64,2,opt,name=upper,proto3,oneof" json:"upper,omitempty"`
+	Count int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *HistogramBucket) Reset() {
+	*x = HistogramBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistogramBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistogramBucket) ProtoMessage() {}
+
+func (x *HistogramBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistogramBucket.ProtoReflect.Descriptor instead.
+func (*HistogramBucket) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HistogramBucket) GetLower() float64 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *HistogramBucket) GetUpper() float64 {
+	if x != nil && x.Upper != nil {
+		return *x.Upper
+	}
+	return 0
+}
+
+func (x *HistogramBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetVoteHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// from defaults to seven days before to, which defaults to now.
+	From       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Resolution HistoryResolution      `protobuf:"varint,4,opt,name=resolution,proto3,enum=crypto.HistoryResolution" json:"resolution,omitempty"`
+	// max_points defaults to 200 and is capped at 1000; the resolution is
+	// coarsened until the range fits.
+	MaxPoints int32 `protobuf:"varint,5,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+}
+
+func (x *GetVoteHistoryRequest) Reset() {
+	*x = GetVoteHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVoteHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVoteHistoryRequest) ProtoMessage() {}
+
+func (x *GetVoteHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVoteHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetVoteHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetVoteHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetVoteHistoryRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetVoteHistoryRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetVoteHistoryRequest) GetResolution() HistoryResolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return HistoryResolution_HISTORY_RESOLUTION_UNSPECIFIED
+}
+
+func (x *GetVoteHistoryRequest) GetMaxPoints() int32 {
+	if x != nil {
+		return x.MaxPoints
+	}
+	return 0
+}
+
+type GetVoteHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points []*VoteHistoryPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	// resolution_seconds is the width of each point after downsampling.
+	ResolutionSeconds int64 `protobuf:"varint,2,opt,name=resolution_seconds,json=resolutionSeconds,proto3" json:"resolution_seconds,omitempty"`
+}
+
+func (x *GetVoteHistoryResponse) Reset() {
+	*x = GetVoteHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVoteHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVoteHistoryResponse) ProtoMessage() {}
+
+func (x *GetVoteHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVoteHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetVoteHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetVoteHistoryResponse) GetPoints() []*VoteHistoryPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *GetVoteHistoryResponse) GetResolutionSeconds() int64 {
+	if x != nil {
+		return x.ResolutionSeconds
+	}
+	return 0
+}
+
+// VoteHistoryPoint holds the last counts recorded within the bucket.
+type VoteHistoryPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bucket   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Likes    int64                  `protobuf:"varint,2,opt,name=likes,proto3" json:"likes,omitempty"`
+	Dislikes int64                  `protobuf:"varint,3,opt,name=dislikes,proto3" json:"dislikes,omitempty"`
+	VoteRate int64                  `protobuf:"varint,4,opt,name=vote_rate,json=voteRate,proto3" json:"vote_rate,omitempty"`
+}
+
+func (x *VoteHistoryPoint) Reset() {
+	*x = VoteHistoryPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoteHistoryPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteHistoryPoint) ProtoMessage() {}
+
+func (x *VoteHistoryPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteHistoryPoint.ProtoReflect.Descriptor instead.
+func (*VoteHistoryPoint) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *VoteHistoryPoint) GetBucket() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Bucket
+	}
+	return nil
+}
+
+func (x *VoteHistoryPoint) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *VoteHistoryPoint) GetDislikes() int64 {
+	if x != nil {
+		return x.Dislikes
+	}
+	return 0
+}
+
+func (x *VoteHistoryPoint) GetVoteRate() int64 {
+	if x != nil {
+		return x.VoteRate
+	}
+	return 0
+}
+
+type FilterByNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *FilterByNameRequest) Reset() {
+	*x = FilterByNameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilterByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterByNameRequest) ProtoMessage() {}
+
+func (x *FilterByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterByNameRequest.ProtoReflect.Descriptor instead.
+func (*FilterByNameRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FilterByNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type FilterByNameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Crypto *Crypto `protobuf:"bytes,1,opt,name=crypto,proto3" json:"crypto,omitempty"`
+}
+
+func (x *FilterByNameResponse) Reset() {
+	*x = FilterByNameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilterByNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterByNameResponse) ProtoMessage() {}
+
+func (x *FilterByNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterByNameResponse.ProtoReflect.Descriptor instead.
+func (*FilterByNameResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FilterByNameResponse) GetCrypto() *Crypto {
+	if x != nil {
+		return x.Crypto
+	}
+	return nil
+}
+
+type GetCryptoBySymbolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+}
+
+func (x *GetCryptoBySymbolRequest) Reset() {
+	*x = GetCryptoBySymbolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCryptoBySymbolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCryptoBySymbolRequest) ProtoMessage() {}
+
+func (x *GetCryptoBySymbolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCryptoBySymbolRequest.ProtoReflect.Descriptor instead.
+func (*GetCryptoBySymbolRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetCryptoBySymbolRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type GetCryptoBySymbolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Crypto *Crypto `protobuf:"bytes,1,opt,name=crypto,proto3" json:"crypto,omitempty"`
+}
+
+func (x *GetCryptoBySymbolResponse) Reset() {
+	*x = GetCryptoBySymbolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCryptoBySymbolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCryptoBySymbolResponse) ProtoMessage() {}
+
+func (x *GetCryptoBySymbolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCryptoBySymbolResponse.ProtoReflect.Descriptor instead.
+func (*GetCryptoBySymbolResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetCryptoBySymbolResponse) GetCrypto() *Crypto {
+	if x != nil {
+		return x.Crypto
+	}
+	return nil
+}
+
+type GetCryptoBySlugRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slug string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+}
+
+func (x *GetCryptoBySlugRequest) Reset() {
+	*x = GetCryptoBySlugRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCryptoBySlugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCryptoBySlugRequest) ProtoMessage() {}
+
+func (x *GetCryptoBySlugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCryptoBySlugRequest.ProtoReflect.Descriptor instead.
+func (*GetCryptoBySlugRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetCryptoBySlugRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type GetCryptoBySlugResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Crypto *Crypto `protobuf:"bytes,1,opt,name=crypto,proto3" json:"crypto,omitempty"`
+}
+
+func (x *GetCryptoBySlugResponse) Reset() {
+	*x = GetCryptoBySlugResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCryptoBySlugResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCryptoBySlugResponse) ProtoMessage() {}
+
+func (x *GetCryptoBySlugResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCryptoBySlugResponse.ProtoReflect.Descriptor instead.
+func (*GetCryptoBySlugResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetCryptoBySlugResponse) GetCrypto() *Crypto {
+	if x != nil {
+		return x.Crypto
+	}
+	return nil
+}
+
+type RestoreCryptoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RestoreCryptoRequest) Reset() {
+	*x = RestoreCryptoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreCryptoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCryptoRequest) ProtoMessage() {}
+
+func (x *RestoreCryptoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCryptoRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCryptoRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RestoreCryptoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreCryptoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Crypto *Crypto `protobuf:"bytes,1,opt,name=crypto,proto3" json:"crypto,omitempty"`
+}
+
+func (x *RestoreCryptoResponse) Reset() {
+	*x = RestoreCryptoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreCryptoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCryptoResponse) ProtoMessage() {}
+
+func (x *RestoreCryptoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCryptoResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCryptoResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RestoreCryptoResponse) GetCrypto() *Crypto {
+	if x != nil {
+		return x.Crypto
+	}
+	return nil
+}
+
+type ListDeletedCryptosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListDeletedCryptosRequest) Reset() {
+	*x = ListDeletedCryptosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeletedCryptosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedCryptosRequest) ProtoMessage() {}
+
+func (x *ListDeletedCryptosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedCryptosRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedCryptosRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{41}
+}
+
+type ListDeletedCryptosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Crypto    *Crypto                `protobuf:"bytes,1,opt,name=crypto,proto3" json:"crypto,omitempty"`
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+}
+
+func (x *ListDeletedCryptosResponse) Reset() {
+	*x = ListDeletedCryptosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeletedCryptosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedCryptosResponse) ProtoMessage() {}
+
+func (x *ListDeletedCryptosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedCryptosResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedCryptosResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListDeletedCryptosResponse) GetCrypto() *Crypto {
+	if x != nil {
+		return x.Crypto
+	}
+	return nil
+}
+
+func (x *ListDeletedCryptosResponse) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type PurgeCryptoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PurgeCryptoRequest) Reset() {
+	*x = PurgeCryptoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeCryptoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeCryptoRequest) ProtoMessage() {}
+
+func (x *PurgeCryptoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeCryptoRequest.ProtoReflect.Descriptor instead.
+func (*PurgeCryptoRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PurgeCryptoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PurgeCryptoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *PurgeCryptoResponse) Reset() {
+	*x = PurgeCryptoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeCryptoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeCryptoResponse) ProtoMessage() {}
+
+func (x *PurgeCryptoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeCryptoResponse.ProtoReflect.Descriptor instead.
+func (*PurgeCryptoResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PurgeCryptoResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type BatchGetCryptosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *BatchGetCryptosRequest) Reset() {
+	*x = BatchGetCryptosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetCryptosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetCryptosRequest) ProtoMessage() {}
+
+func (x *BatchGetCryptosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetCryptosRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetCryptosRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *BatchGetCryptosRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchGetCryptosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// results follow the order of the requested ids.
+	Results []*BatchGetCryptoResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchGetCryptosResponse) Reset() {
+	*x = BatchGetCryptosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetCryptosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetCryptosResponse) ProtoMessage() {}
+
+func (x *BatchGetCryptosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetCryptosResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetCryptosResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *BatchGetCryptosResponse) GetResults() []*BatchGetCryptoResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchGetCryptoResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Crypto   *Crypto `protobuf:"bytes,2,opt,name=crypto,proto3" json:"crypto,omitempty"`
+	NotFound bool    `protobuf:"varint,3,opt,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+}
+
+func (x *BatchGetCryptoResult) Reset() {
+	*x = BatchGetCryptoResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetCryptoResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetCryptoResult) ProtoMessage() {}
+
+func (x *BatchGetCryptoResult) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetCryptoResult.ProtoReflect.Descriptor instead.
+func (*BatchGetCryptoResult) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *BatchGetCryptoResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BatchGetCryptoResult) GetCrypto() *Crypto {
+	if x != nil {
+		return x.Crypto
+	}
+	return nil
+}
+
+func (x *BatchGetCryptoResult) GetNotFound() bool {
+	if x != nil {
+		return x.NotFound
+	}
+	return false
+}
+
+type BatchCreateCryptosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*CreateCryptoRequest `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// partial creates every valid item; otherwise nothing is created if any item
+	// fails, which needs a replica set and fails with FAILED_PRECONDITION without one.
+	Partial bool `protobuf:"varint,2,opt,name=partial,proto3" json:"partial,omitempty"`
+}
+
+func (x *BatchCreateCryptosRequest) Reset() {
+	*x = BatchCreateCryptosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateCryptosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateCryptosRequest) ProtoMessage() {}
+
+func (x *BatchCreateCryptosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateCryptosRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateCryptosRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *BatchCreateCryptosRequest) GetItems() []*CreateCryptoRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchCreateCryptosRequest) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+type BatchCreateCryptosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*BatchCreateCryptoResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchCreateCryptosResponse) Reset() {
+	*x = BatchCreateCryptosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateCryptosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateCryptosResponse) ProtoMessage() {}
+
+func (x *BatchCreateCryptosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateCryptosResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateCryptosResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BatchCreateCryptosResponse) GetResults() []*BatchCreateCryptoResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchCreateCryptoResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Crypto *Crypto `protobuf:"bytes,1,opt,name=crypto,proto3" json:"crypto,omitempty"`
+	Error  string  `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BatchCreateCryptoResult) Reset() {
+	*x = BatchCreateCryptoResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateCryptoResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateCryptoResult) ProtoMessage() {}
+
+func (x *BatchCreateCryptoResult) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateCryptoResult.ProtoReflect.Descriptor instead.
+func (*BatchCreateCryptoResult) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BatchCreateCryptoResult) GetCrypto() *Crypto {
+	if x != nil {
+		return x.Crypto
+	}
+	return nil
+}
+
+func (x *BatchCreateCryptoResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BatchVoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Votes []*Vote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (x *BatchVoteRequest) Reset() {
+	*x = BatchVoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchVoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchVoteRequest) ProtoMessage() {}
+
+func (x *BatchVoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchVoteRequest.ProtoReflect.Descriptor instead.
+func (*BatchVoteRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *BatchVoteRequest) GetVotes() []*Vote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+type Vote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Direction VoteDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=crypto.VoteDirection" json:"direction,omitempty"`
+}
+
+func (x *Vote) Reset() {
+	*x = Vote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Vote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vote) ProtoMessage() {}
+
+func (x *Vote) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vote.ProtoReflect.Descriptor instead.
+func (*Vote) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Vote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Vote) GetDirection() VoteDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return VoteDirection_VOTE_DIRECTION_UNSPECIFIED
+}
+
+type BatchVoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*BatchVoteResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchVoteResponse) Reset() {
+	*x = BatchVoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchVoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchVoteResponse) ProtoMessage() {}
+
+func (x *BatchVoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchVoteResponse.ProtoReflect.Descriptor instead.
+func (*BatchVoteResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *BatchVoteResponse) GetResults() []*BatchVoteResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchVoteResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Crypto   *Crypto `protobuf:"bytes,2,opt,name=crypto,proto3" json:"crypto,omitempty"`
+	NotFound bool    `protobuf:"varint,3,opt,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+}
+
+func (x *BatchVoteResult) Reset() {
+	*x = BatchVoteResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchVoteResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchVoteResult) ProtoMessage() {}
+
+func (x *BatchVoteResult) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchVoteResult.ProtoReflect.Descriptor instead.
+func (*BatchVoteResult) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *BatchVoteResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BatchVoteResult) GetCrypto() *Crypto {
+	if x != nil {
+		return x.Crypto
+	}
+	return nil
+}
+
+func (x *BatchVoteResult) GetNotFound() bool {
+	if x != nil {
+		return x.NotFound
+	}
+	return false
+}
+
+// ImportCryptoItem mirrors CreateCryptoRequest; rules are checked per row so
+// that a bad row is reported instead of aborting the whole import.
+type ImportCryptoItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Symbol      string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Slug        string `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+}
+
+func (x *ImportCryptoItem) Reset() {
+	*x = ImportCryptoItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportCryptoItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCryptoItem) ProtoMessage() {}
+
+func (x *ImportCryptoItem) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCryptoItem.ProtoReflect.Descriptor instead.
+func (*ImportCryptoItem) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ImportCryptoItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportCryptoItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ImportCryptoItem) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ImportCryptoItem) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type ImportCryptosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Created int64             `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Updated int64             `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Skipped int64             `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Failed  int64             `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Errors  []*ImportRowError `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *ImportCryptosResponse) Reset() {
+	*x = ImportCryptosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportCryptosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCryptosResponse) ProtoMessage() {}
+
+func (x *ImportCryptosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCryptosResponse.ProtoReflect.Descriptor instead.
+func (*ImportCryptosResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ImportCryptosResponse) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportCryptosResponse) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportCryptosResponse) GetSkipped() int64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ImportCryptosResponse) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportCryptosResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ImportRowError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// row is the 1-based position of the item in the stream.
+	Row    int64  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Error  string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ImportRowError) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ExportCryptosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format         ExportFormat `protobuf:"varint,1,opt,name=format,proto3,enum=crypto.ExportFormat" json:"format,omitempty"`
+	IncludeDeleted bool         `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+}
+
+func (x *ExportCryptosRequest) Reset() {
+	*x = ExportCryptosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportCryptosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCryptosRequest) ProtoMessage() {}
+
+func (x *ExportCryptosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCryptosRequest.ProtoReflect.Descriptor instead.
+func (*ExportCryptosRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ExportCryptosRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportCryptosRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+// ExportCryptosResponse carries the next chunk of the serialized file;
+// concatenating every chunk yields the same file as the catalog export command.
+type ExportCryptosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExportCryptosResponse) Reset() {
+	*x = ExportCryptosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportCryptosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCryptosResponse) ProtoMessage() {}
+
+func (x *ExportCryptosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCryptosResponse.ProtoReflect.Descriptor instead.
+func (*ExportCryptosResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ExportCryptosResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SearchCryptosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query string     `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Mode  SearchMode `protobuf:"varint,2,opt,name=mode,proto3,enum=crypto.SearchMode" json:"mode,omitempty"`
+	// page_size defaults to 20 and is capped at 50.
+	PageSize  int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *SearchCryptosRequest) Reset() {
+	*x = SearchCryptosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchCryptosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCryptosRequest) ProtoMessage() {}
+
+func (x *SearchCryptosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCryptosRequest.ProtoReflect.Descriptor instead.
+func (*SearchCryptosRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SearchCryptosRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchCryptosRequest) GetMode() SearchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SearchMode_SEARCH_MODE_UNSPECIFIED
+}
+
+func (x *SearchCryptosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchCryptosRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchCryptosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hits          []*SearchHit `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	NextPageToken string       `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Total         int64        `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *SearchCryptosResponse) Reset() {
+	*x = SearchCryptosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchCryptosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCryptosResponse) ProtoMessage() {}
+
+func (x *SearchCryptosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCryptosResponse.ProtoReflect.Descriptor instead.
+func (*SearchCryptosResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SearchCryptosResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchCryptosResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchCryptosResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type SearchHit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Crypto     *Crypto      `protobuf:"bytes,1,opt,name=crypto,proto3" json:"crypto,omitempty"`
+	Score      float64      `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Highlights []*Highlight `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SearchHit) GetCrypto() *Crypto {
+	if x != nil {
+		return x.Crypto
+	}
+	return nil
+}
+
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchHit) GetHighlights() []*Highlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type Highlight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field   string        `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Text    string        `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Matches []*MatchRange `protobuf:"bytes,3,rep,name=matches,proto3" json:"matches,omitempty"`
+}
+
+func (x *Highlight) Reset() {
+	*x = Highlight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Highlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Highlight) ProtoMessage() {}
+
+func (x *Highlight) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Highlight.ProtoReflect.Descriptor instead.
+func (*Highlight) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Highlight) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Highlight) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Highlight) GetMatches() []*MatchRange {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+// MatchRange is a half-open range of character (not byte) offsets in Highlight.text.
+type MatchRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start int32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   int32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *MatchRange) Reset() {
+	*x = MatchRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchRange) ProtoMessage() {}
+
+func (x *MatchRange) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchRange.ProtoReflect.Descriptor instead.
+func (*MatchRange) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *MatchRange) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *MatchRange) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type SuggestCryptosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// limit defaults to 10 and is capped at 20.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SuggestCryptosRequest) Reset() {
+	*x = SuggestCryptosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestCryptosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestCryptosRequest) ProtoMessage() {}
+
+func (x *SuggestCryptosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestCryptosRequest.ProtoReflect.Descriptor instead.
+func (*SuggestCryptosRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SuggestCryptosRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SuggestCryptosRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SuggestCryptosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Suggestions []*Suggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+}
+
+func (x *SuggestCryptosResponse) Reset() {
+	*x = SuggestCryptosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestCryptosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestCryptosResponse) ProtoMessage() {}
+
+func (x *SuggestCryptosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestCryptosResponse.ProtoReflect.Descriptor instead.
+func (*SuggestCryptosResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SuggestCryptosResponse) GetSuggestions() []*Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type Suggestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol   string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Slug     string `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	VoteRate int64  `protobuf:"varint,5,opt,name=vote_rate,json=voteRate,proto3" json:"vote_rate,omitempty"`
+}
+
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestion) ProtoMessage() {}
+
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Suggestion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Suggestion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Suggestion) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Suggestion) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Suggestion) GetVoteRate() int64 {
+	if x != nil {
+		return x.VoteRate
+	}
+	return 0
+}
+
+type ListTrendingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Window TrendingWindow `protobuf:"varint,1,opt,name=window,proto3,enum=crypto.TrendingWindow" json:"window,omitempty"`
+	// limit defaults to 10 and is capped at 100.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListTrendingRequest) Reset() {
+	*x = ListTrendingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTrendingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingRequest) ProtoMessage() {}
+
+func (x *ListTrendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingRequest.ProtoReflect.Descriptor instead.
+func (*ListTrendingRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListTrendingRequest) GetWindow() TrendingWindow {
+	if x != nil {
+		return x.Window
+	}
+	return TrendingWindow_TRENDING_WINDOW_UNSPECIFIED
+}
+
+func (x *ListTrendingRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListTrendingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cryptos []*TrendingCrypto `protobuf:"bytes,1,rep,name=cryptos,proto3" json:"cryptos,omitempty"`
+}
+
+func (x *ListTrendingResponse) Reset() {
+	*x = ListTrendingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTrendingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingResponse) ProtoMessage() {}
+
+func (x *ListTrendingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingResponse.ProtoReflect.Descriptor instead.
+func (*ListTrendingResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListTrendingResponse) GetCryptos() []*TrendingCrypto {
+	if x != nil {
+		return x.Cryptos
+	}
+	return nil
+}
+
+// TrendingCrypto carries the votes cast on a crypto within the window.
+type TrendingCrypto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Crypto   *Crypto `protobuf:"bytes,1,opt,name=crypto,proto3" json:"crypto,omitempty"`
+	NetVotes int64   `protobuf:"varint,2,opt,name=net_votes,json=netVotes,proto3" json:"net_votes,omitempty"`
+	Likes    int64   `protobuf:"varint,3,opt,name=likes,proto3" json:"likes,omitempty"`
+	Dislikes int64   `protobuf:"varint,4,opt,name=dislikes,proto3" json:"dislikes,omitempty"`
+}
+
+func (x *TrendingCrypto) Reset() {
+	*x = TrendingCrypto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrendingCrypto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingCrypto) ProtoMessage() {}
+
+func (x *TrendingCrypto) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingCrypto.ProtoReflect.Descriptor instead.
+func (*TrendingCrypto) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *TrendingCrypto) GetCrypto() *Crypto {
+	if x != nil {
+		return x.Crypto
+	}
+	return nil
+}
+
+func (x *TrendingCrypto) GetNetVotes() int64 {
+	if x != nil {
+		return x.NetVotes
+	}
+	return 0
+}
+
+func (x *TrendingCrypto) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *TrendingCrypto) GetDislikes() int64 {
+	if x != nil {
+		return x.Dislikes
+	}
+	return 0
+}
+
+type WatchCryptosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ids restricts the stream to these cryptos when not empty.
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *WatchCryptosRequest) Reset() {
+	*x = WatchCryptosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchCryptosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCryptosRequest) ProtoMessage() {}
+
+func (x *WatchCryptosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCryptosRequest.ProtoReflect.Descriptor instead.
+func (*WatchCryptosRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *WatchCryptosRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type WatchCryptosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type is the event type, e.g. "crypto.voted"; "catalog.imported" means
+	// many cryptos changed at once and should be fetched again.
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Crypto        *Crypto                `protobuf:"bytes,3,opt,name=crypto,proto3" json:"crypto,omitempty"`
+	LikesDelta    int64                  `protobuf:"varint,4,opt,name=likes_delta,json=likesDelta,proto3" json:"likes_delta,omitempty"`
+	DislikesDelta int64                  `protobuf:"varint,5,opt,name=dislikes_delta,json=dislikesDelta,proto3" json:"dislikes_delta,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+}
+
+func (x *WatchCryptosResponse) Reset() {
+	*x = WatchCryptosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchCryptosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCryptosResponse) ProtoMessage() {}
+
+func (x *WatchCryptosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCryptosResponse.ProtoReflect.Descriptor instead.
+func (*WatchCryptosResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *WatchCryptosResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WatchCryptosResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WatchCryptosResponse) GetCrypto() *Crypto {
+	if x != nil {
+		return x.Crypto
+	}
+	return nil
+}
+
+func (x *WatchCryptosResponse) GetLikesDelta() int64 {
+	if x != nil {
+		return x.LikesDelta
+	}
+	return 0
+}
+
+func (x *WatchCryptosResponse) GetDislikesDelta() int64 {
+	if x != nil {
+		return x.DislikesDelta
+	}
+	return 0
+}
+
+func (x *WatchCryptosResponse) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type GetCacheStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetCacheStatsRequest) Reset() {
+	*x = GetCacheStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCacheStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCacheStatsRequest) ProtoMessage() {}
+
+func (x *GetCacheStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCacheStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCacheStatsRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{73}
+}
+
+type GetCacheStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// enabled is false when the server runs without a cache.
+	Enabled bool  `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Hits    int64 `protobuf:"varint,2,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses  int64 `protobuf:"varint,3,opt,name=misses,proto3" json:"misses,omitempty"`
+	// shared_loads counts misses served by a load another request started.
+	SharedLoads   int64 `protobuf:"varint,4,opt,name=shared_loads,json=sharedLoads,proto3" json:"shared_loads,omitempty"`
+	Errors        int64 `protobuf:"varint,5,opt,name=errors,proto3" json:"errors,omitempty"`
+	Invalidations int64 `protobuf:"varint,6,opt,name=invalidations,proto3" json:"invalidations,omitempty"`
+	// evictions and entries are only reported by the in-memory cache.
+	Evictions int64   `protobuf:"varint,7,opt,name=evictions,proto3" json:"evictions,omitempty"`
+	Entries   int64   `protobuf:"varint,8,opt,name=entries,proto3" json:"entries,omitempty"`
+	HitRatio  float64 `protobuf:"fixed64,9,opt,name=hit_ratio,json=hitRatio,proto3" json:"hit_ratio,omitempty"`
+}
+
+func (x *GetCacheStatsResponse) Reset() {
+	*x = GetCacheStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCacheStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCacheStatsResponse) ProtoMessage() {}
+
+func (x *GetCacheStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCacheStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCacheStatsResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetCacheStatsResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GetCacheStatsResponse) GetHits() int64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *GetCacheStatsResponse) GetMisses() int64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *GetCacheStatsResponse) GetSharedLoads() int64 {
+	if x != nil {
+		return x.SharedLoads
+	}
+	return 0
+}
+
+func (x *GetCacheStatsResponse) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *GetCacheStatsResponse) GetInvalidations() int64 {
+	if x != nil {
+		return x.Invalidations
+	}
+	return 0
+}
+
+func (x *GetCacheStatsResponse) GetEvictions() int64 {
+	if x != nil {
+		return x.Evictions
+	}
+	return 0
+}
+
+func (x *GetCacheStatsResponse) GetEntries() int64 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *GetCacheStatsResponse) GetHitRatio() float64 {
+	if x != nil {
+		return x.HitRatio
+	}
+	return 0
+}
+
+// CastSignedVoteRequest is a vote signed by a wallet. The signed message is
+// described in the README; each wallet counts once per crypto.
+type CastSignedVoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Direction VoteDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=crypto.VoteDirection" json:"direction,omitempty"`
+	// nonce may be used once per wallet.
+	Nonce string `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// timestamp is the Unix time the vote was signed at.
+	Timestamp int64           `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Scheme    SignatureScheme `protobuf:"varint,5,opt,name=scheme,proto3,enum=crypto.SignatureScheme" json:"scheme,omitempty"`
+	PublicKey []byte          `protobuf:"bytes,6,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signature []byte          `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *CastSignedVoteRequest) Reset() {
+	*x = CastSignedVoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CastSignedVoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CastSignedVoteRequest) ProtoMessage() {}
+
+func (x *CastSignedVoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CastSignedVoteRequest.ProtoReflect.Descriptor instead.
+func (*CastSignedVoteRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CastSignedVoteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CastSignedVoteRequest) GetDirection() VoteDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return VoteDirection_VOTE_DIRECTION_UNSPECIFIED
+}
+
+func (x *CastSignedVoteRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *CastSignedVoteRequest) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *CastSignedVoteRequest) GetScheme() SignatureScheme {
+	if x != nil {
+		return x.Scheme
+	}
+	return SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED
+}
+
+func (x *CastSignedVoteRequest) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *CastSignedVoteRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type CastSignedVoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Crypto *Crypto `protobuf:"bytes,1,opt,name=crypto,proto3" json:"crypto,omitempty"`
+	// address is the wallet the vote was recorded for.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// applied is false when the wallet had already cast, or not cast, the vote.
+	Applied bool `protobuf:"varint,3,opt,name=applied,proto3" json:"applied,omitempty"`
+}
+
+func (x *CastSignedVoteResponse) Reset() {
+	*x = CastSignedVoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CastSignedVoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CastSignedVoteResponse) ProtoMessage() {}
+
+func (x *CastSignedVoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CastSignedVoteResponse.ProtoReflect.Descriptor instead.
+func (*CastSignedVoteResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CastSignedVoteResponse) GetCrypto() *Crypto {
+	if x != nil {
+		return x.Crypto
+	}
+	return nil
+}
+
+func (x *CastSignedVoteResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CastSignedVoteResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+type FraudCase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CryptoId      string `protobuf:"bytes,2,opt,name=crypto_id,json=cryptoId,proto3" json:"crypto_id,omitempty"`
+	LikesDelta    int64  `protobuf:"varint,3,opt,name=likes_delta,json=likesDelta,proto3" json:"likes_delta,omitempty"`
+	DislikesDelta int64  `protobuf:"varint,4,opt,name=dislikes_delta,json=dislikesDelta,proto3" json:"dislikes_delta,omitempty"`
+	Ip            string `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`
+	// network is the autonomous system or address range of the ip.
+	Network string `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
+	// voter is the wallet of a signed vote.
+	Voter   string      `protobuf:"bytes,7,opt,name=voter,proto3" json:"voter,omitempty"`
+	Score   float64     `protobuf:"fixed64,8,opt,name=score,proto3" json:"score,omitempty"`
+	Reasons []string    `protobuf:"bytes,9,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	Action  FraudAction `protobuf:"varint,10,opt,name=action,proto3,enum=crypto.FraudAction" json:"action,omitempty"`
+	// counted tells whether the vote is part of the counts.
+	Counted bool            `protobuf:"varint,11,opt,name=counted,proto3" json:"counted,omitempty"`
+	Status  FraudCaseStatus `protobuf:"varint,12,opt,name=status,proto3,enum=crypto.FraudCaseStatus" json:"status,omitempty"`
+	// quarantined_until is set when the vote quarantined its network and wallet.
+	QuarantinedUntil *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=quarantined_until,json=quarantinedUntil,proto3" json:"quarantined_until,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReviewedAt       *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+}
+
+func (x *FraudCase) Reset() {
+	*x = FraudCase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FraudCase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FraudCase) ProtoMessage() {}
+
+func (x *FraudCase) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FraudCase.ProtoReflect.Descriptor instead.
+func (*FraudCase) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *FraudCase) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FraudCase) GetCryptoId() string {
+	if x != nil {
+		return x.CryptoId
+	}
+	return ""
+}
+
+func (x *FraudCase) GetLikesDelta() int64 {
+	if x != nil {
+		return x.LikesDelta
+	}
+	return 0
+}
+
+func (x *FraudCase) GetDislikesDelta() int64 {
+	if x != nil {
+		return x.DislikesDelta
+	}
+	return 0
+}
+
+func (x *FraudCase) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *FraudCase) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *FraudCase) GetVoter() string {
+	if x != nil {
+		return x.Voter
+	}
+	return ""
+}
+
+func (x *FraudCase) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *FraudCase) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *FraudCase) GetAction() FraudAction {
+	if x != nil {
+		return x.Action
+	}
+	return FraudAction_FRAUD_ACTION_UNSPECIFIED
+}
+
+func (x *FraudCase) GetCounted() bool {
+	if x != nil {
+		return x.Counted
+	}
+	return false
+}
+
+func (x *FraudCase) GetStatus() FraudCaseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FraudCaseStatus_FRAUD_CASE_STATUS_UNSPECIFIED
+}
+
+func (x *FraudCase) GetQuarantinedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.QuarantinedUntil
+	}
+	return nil
+}
+
+func (x *FraudCase) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FraudCase) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+type ListFraudCasesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// crypto_id narrows the list to one crypto when set.
+	CryptoId string `protobuf:"bytes,1,opt,name=crypto_id,json=cryptoId,proto3" json:"crypto_id,omitempty"`
+	// status narrows the list, OPEN listing the cases waiting for review.
+	Status FraudCaseStatus `protobuf:"varint,2,opt,name=status,proto3,enum=crypto.FraudCaseStatus" json:"status,omitempty"`
+	// limit defaults to 50 and is capped at 500; the newest cases come first.
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListFraudCasesRequest) Reset() {
+	*x = ListFraudCasesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFraudCasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFraudCasesRequest) ProtoMessage() {}
+
+func (x *ListFraudCasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFraudCasesRequest.ProtoReflect.Descriptor instead.
+func (*ListFraudCasesRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListFraudCasesRequest) GetCryptoId() string {
+	if x != nil {
+		return x.CryptoId
+	}
+	return ""
+}
+
+func (x *ListFraudCasesRequest) GetStatus() FraudCaseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FraudCaseStatus_FRAUD_CASE_STATUS_UNSPECIFIED
+}
+
+func (x *ListFraudCasesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListFraudCasesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cases []*FraudCase `protobuf:"bytes,1,rep,name=cases,proto3" json:"cases,omitempty"`
+}
+
+func (x *ListFraudCasesResponse) Reset() {
+	*x = ListFraudCasesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFraudCasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFraudCasesResponse) ProtoMessage() {}
+
+func (x *ListFraudCasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFraudCasesResponse.ProtoReflect.Descriptor instead.
+func (*ListFraudCasesResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListFraudCasesResponse) GetCases() []*FraudCase {
+	if x != nil {
+		return x.Cases
+	}
+	return nil
+}
+
+// ReviewFraudCaseRequest closes an open case. Approving counts a vote that
+// was not counted and lifts the quarantine it started; rejecting takes a
+// counted vote back out of the counts.
+type ReviewFraudCaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status FraudCaseStatus `protobuf:"varint,2,opt,name=status,proto3,enum=crypto.FraudCaseStatus" json:"status,omitempty"`
+}
+
+func (x *ReviewFraudCaseRequest) Reset() {
+	*x = ReviewFraudCaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewFraudCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewFraudCaseRequest) ProtoMessage() {}
+
+func (x *ReviewFraudCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewFraudCaseRequest.ProtoReflect.Descriptor instead.
+func (*ReviewFraudCaseRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ReviewFraudCaseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReviewFraudCaseRequest) GetStatus() FraudCaseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FraudCaseStatus_FRAUD_CASE_STATUS_UNSPECIFIED
+}
+
+type ReviewFraudCaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Case   *FraudCase `protobuf:"bytes,1,opt,name=case,proto3" json:"case,omitempty"`
+	Crypto *Crypto    `protobuf:"bytes,2,opt,name=crypto,proto3" json:"crypto,omitempty"`
+}
+
+func (x *ReviewFraudCaseResponse) Reset() {
+	*x = ReviewFraudCaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewFraudCaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewFraudCaseResponse) ProtoMessage() {}
+
+func (x *ReviewFraudCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewFraudCaseResponse.ProtoReflect.Descriptor instead.
+func (*ReviewFraudCaseResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ReviewFraudCaseResponse) GetCase() *FraudCase {
+	if x != nil {
+		return x.Case
+	}
+	return nil
+}
+
+func (x *ReviewFraudCaseResponse) GetCrypto() *Crypto {
+	if x != nil {
+		return x.Crypto
+	}
+	return nil
+}
+
+type GetVoteChallengeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetVoteChallengeRequest) Reset() {
+	*x = GetVoteChallengeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVoteChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVoteChallengeRequest) ProtoMessage() {}
+
+func (x *GetVoteChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVoteChallengeRequest.ProtoReflect.Descriptor instead.
+func (*GetVoteChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{82}
+}
+
+// GetVoteChallengeResponse is a challenge to solve before an anonymous
+// AddLike or AddDislike: find a solution such that the SHA-256 of
+// "<challenge>:<solution>" starts with difficulty zero bits.
+type GetVoteChallengeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// required is false when the server accepts votes without a challenge.
+	Required   bool                   `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`
+	Challenge  string                 `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Difficulty int32                  `protobuf:"varint,3,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	ExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *GetVoteChallengeResponse) Reset() {
+	*x = GetVoteChallengeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVoteChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVoteChallengeResponse) ProtoMessage() {}
+
+func (x *GetVoteChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVoteChallengeResponse.ProtoReflect.Descriptor instead.
+func (*GetVoteChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetVoteChallengeResponse) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *GetVoteChallengeResponse) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *GetVoteChallengeResponse) GetDifficulty() int32 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *GetVoteChallengeResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+var File_crypto_proto protoreflect.FileDescriptor
+
+var file_crypto_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x1a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x0f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xac, 0x01, 0x0a, 0x06, 0x43, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22, 0xdd, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x8a, 0xb5,
+	0x18, 0x1b, 0x18, 0x40, 0x22, 0x15, 0x5e, 0x5b, 0x5c, 0x70, 0x7b, 0x4c, 0x7d, 0x5c, 0x70, 0x7b,
+	0x4e, 0x7d, 0x20, 0x2e, 0x27, 0x28, 0x29, 0x2d, 0x5d, 0x2b, 0x24, 0x08, 0x01, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0x8a, 0xb5, 0x18, 0x03, 0x18, 0xf4,
+	0x03, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30,
+	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0x8a, 0xb5, 0x18, 0x14, 0x22, 0x0e, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d,
+	0x39, 0x5d, 0x2b, 0x24, 0x08, 0x01, 0x18, 0x0a, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x12, 0x34, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20,
+	0x8a, 0xb5, 0x18, 0x1c, 0x22, 0x18, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x2b,
+	0x28, 0x2d, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x29, 0x2a, 0x24, 0x18, 0x40,
+	0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22, 0x58, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x22, 0x47, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x53, 0x0a, 0x13, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x26, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x2b,
+	0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0x8a, 0xb5, 0x18, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3c, 0x0a, 0x12, 0x52,
+	0x65, 0x61, 0x64, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x22, 0xf3, 0x01, 0x0a, 0x13, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a,
+	0xb5, 0x18, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x8a, 0xb5, 0x18, 0x1b, 0x22, 0x15, 0x5e,
+	0x5b, 0x5c, 0x70, 0x7b, 0x4c, 0x7d, 0x5c, 0x70, 0x7b, 0x4e, 0x7d, 0x20, 0x2e, 0x27, 0x28, 0x29,
+	0x2d, 0x5d, 0x2b, 0x24, 0x08, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0x8a, 0xb5, 0x18, 0x03, 0x18, 0xf4, 0x03, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0x8a, 0xb5, 0x18, 0x12, 0x18,
+	0x0a, 0x22, 0x0e, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x2b,
+	0x24, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x34, 0x0a, 0x04, 0x73, 0x6c, 0x75,
+	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x8a, 0xb5, 0x18, 0x1c, 0x18, 0x40, 0x22,
+	0x18, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x28, 0x2d, 0x5b, 0x61, 0x2d,
+	0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x29, 0x2a, 0x24, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22,
+	0x58, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x22, 0x2d, 0x0a, 0x13, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5,
+	0x18, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x28, 0x0a, 0x0e, 0x41, 0x64,
+	0x64, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x28, 0x01,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x39, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x22,
+	0x2b, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3c, 0x0a, 0x12,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x22, 0x2b, 0x0a, 0x11, 0x41, 0x64,
+	0x64, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18,
+	0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3c, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x44, 0x69,
+	0x73, 0x6c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
+	0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x06, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x22, 0x2e, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44,
+	0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x28,
+	0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3f, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44,
+	0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
+	0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x06,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x22, 0x2b, 0x0a, 0x11, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56,
+	0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x28, 0x01, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x67, 0x0a, 0x12, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x56, 0x6f, 0x74, 0x65,
+	0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x05, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x22, 0xba, 0x01, 0x0a,
+	0x09, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x76, 0x6f, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x41, 0x74, 0x22, 0x52, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x56, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5,
+	0x18, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x8a, 0xb5, 0x18, 0x05, 0x08,
+	0x01, 0x18, 0x80, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xae, 0x01,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x56,
+	0x6f, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x05, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x6c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x6c, 0x65, 0x61, 0x66, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x33,
+	0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x53, 0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c,
+	0x65, 0x66, 0x74, 0x22, 0x2f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x28, 0x01,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0xe0, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x26, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52,
+	0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x76,
+	0x65, 0x72, 0x73, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x74, 0x61, 0x6c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x80, 0x03, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x69, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6e, 0x65, 0x75, 0x74, 0x72, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6e,
+	0x65, 0x75, 0x74, 0x72, 0x61, 0x6c, 0x12, 0x46, 0x0a, 0x12, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f,
+	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x11, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44,
+	0x0a, 0x11, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x10, 0x76, 0x6f, 0x74, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x62, 0x0a, 0x0f, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61,
+	0x6d, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x19, 0x0a,
+	0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x05,
+	0x75, 0x70, 0x70, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x22, 0xe5, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x56, 0x6f, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06,
+	0x8a, 0xb5, 0x18, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x22, 0x79, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x12,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x10,
+	0x56, 0x6f, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x32, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
+	0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x6f, 0x74, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x22, 0x31, 0x0a, 0x13, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x18, 0x40,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x14, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
+	0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x06,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x22, 0x4c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x42, 0x79, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0x8a, 0xb5, 0x18, 0x14, 0x22, 0x0e, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61,
+	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x24, 0x08, 0x01, 0x18, 0x0a, 0x52, 0x06, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x43, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x42, 0x79, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x22, 0x50, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x22, 0x8a, 0xb5, 0x18, 0x1e, 0x08, 0x01, 0x18, 0x40, 0x22, 0x18, 0x5e, 0x5b, 0x61,
+	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x28, 0x2d, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39,
+	0x5d, 0x2b, 0x29, 0x2a, 0x24, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22, 0x41, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e,
+	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x22, 0x2e,
+	0x0a, 0x14, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3f,
+	0x0a, 0x15, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x22,
+	0x1b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x72,
+	0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7f, 0x0a, 0x1a,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x06, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2c, 0x0a,
+	0x12, 0x50, 0x75, 0x72, 0x67, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0x8a, 0xb5, 0x18, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2f, 0x0a, 0x13, 0x50,
+	0x75, 0x72, 0x67, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x36, 0x0a, 0x16,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x0a, 0x8a, 0xb5, 0x18, 0x06, 0x08, 0x01, 0x30, 0x64, 0x28, 0x01, 0x52,
+	0x03, 0x69, 0x64, 0x73, 0x22, 0x51, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x36, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x6b, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x47, 0x65, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x26, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52,
+	0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x66,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x46,
+	0x6f, 0x75, 0x6e, 0x64, 0x22, 0x72, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x08, 0x8a,
+	0xb5, 0x18, 0x04, 0x08, 0x01, 0x30, 0x64, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x22, 0x57, 0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0x57, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x06,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x06, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x40, 0x0a, 0x10, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x08, 0x8a, 0xb5, 0x18,
+	0x04, 0x30, 0x64, 0x08, 0x01, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x04,
+	0x56, 0x6f, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x09,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x52, 0x09,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x11, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0x66, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72,
+	0x79, 0x70, 0x74, 0x6f, 0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x12, 0x1b, 0x0a, 0x09,
+	0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x74, 0x0a, 0x10, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x6c, 0x75, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22,
+	0xad, 0x01, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12,
+	0x2e, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22,
+	0x50, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x75, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42,
+	0x06, 0x8a, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x2b, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa2, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
+	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0x8a,
+	0xb5, 0x18, 0x04, 0x08, 0x01, 0x18, 0x40, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x26,
+	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x25, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x18, 0x20, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7c, 0x0a, 0x15, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x48, 0x69, 0x74, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x7c, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x48, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x2e, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x0a, 0x68, 0x69, 0x67, 0x68,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0x63, 0x0a, 0x09, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2c, 0x0a,
+	0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x0a, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x6e,
+	0x64, 0x22, 0x4f, 0x0a, 0x15, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x43, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04,
+	0x08, 0x01, 0x18, 0x40, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0x4e, 0x0a, 0x16, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x43, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0b,
+	0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x79, 0x0a, 0x0a, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x6c, 0x75, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67,
+	0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x6f, 0x74, 0x65, 0x52, 0x61, 0x74, 0x65, 0x22, 0x5b, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x54, 0x72,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x48, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x07, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x12,
+	0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x74, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6e, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b,
+	0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x22, 0x31,
+	0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x30, 0x64, 0x28, 0x01, 0x52, 0x03, 0x69, 0x64,
+	0x73, 0x22, 0xe7, 0x01, 0x0a, 0x14, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26,
+	0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x06,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x5f,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x69, 0x6b,
+	0x65, 0x73, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x6c, 0x69,
+	0x6b, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x3b,
+	0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x93, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x69, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x69, 0x73,
+	0x73, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x6f,
+	0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x4c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x68, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x68, 0x69, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x22, 0xa2, 0x02, 0x0a, 0x15, 0x43, 0x61,
+	0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0x8a, 0xb5, 0x18, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x09, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x52, 0x09, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x8a, 0xb5, 0x18, 0x06, 0x18, 0x40, 0x08,
+	0x01, 0x10, 0x08, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x65, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x74,
+	0x0a, 0x16, 0x43, 0x61, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x22, 0xa9, 0x04, 0x0a, 0x09, 0x46, 0x72, 0x61, 0x75, 0x64, 0x43, 0x61,
+	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x44, 0x65, 0x6c, 0x74, 0x61,
+	0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b,
+	0x65, 0x73, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x2e, 0x46, 0x72, 0x61, 0x75, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x2f,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x46, 0x72, 0x61, 0x75, 0x64, 0x43, 0x61, 0x73,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x47, 0x0a, 0x11, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69,
+	0x6e, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x83, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x61, 0x75, 0x64, 0x43, 0x61,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x09, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a,
+	0xb5, 0x18, 0x02, 0x18, 0x18, 0x52, 0x08, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x49, 0x64, 0x12,
+	0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x46, 0x72, 0x61, 0x75, 0x64, 0x43, 0x61,
+	0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x41, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72,
+	0x61, 0x75, 0x64, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x27, 0x0a, 0x05, 0x63, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x46, 0x72, 0x61, 0x75, 0x64, 0x43, 0x61,
+	0x73, 0x65, 0x52, 0x05, 0x63, 0x61, 0x73, 0x65, 0x73, 0x22, 0x69, 0x0a, 0x16, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x46, 0x72, 0x61, 0x75, 0x64, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0x8a, 0xb5, 0x18, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x6f, 0x2e, 0x46, 0x72, 0x61, 0x75, 0x64, 0x43, 0x61, 0x73, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x68, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x46, 0x72,
+	0x61, 0x75, 0x64, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x25, 0x0a, 0x04, 0x63, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x46, 0x72, 0x61, 0x75, 0x64, 0x43, 0x61, 0x73, 0x65,
+	0x52, 0x04, 0x63, 0x61, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e,
+	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x22, 0x19,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xaf, 0x01, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79,
+	0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x2a, 0x83, 0x01, 0x0a, 0x0f,
+	0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12,
+	0x20, 0x0a, 0x1c, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54,
+	0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x45, 0x54, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x10, 0x01,
+	0x12, 0x09, 0x0a, 0x05, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x57,
+	0x49, 0x4c, 0x53, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x41, 0x59, 0x45, 0x53,
+	0x49, 0x41, 0x4e, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x4f, 0x54, 0x10, 0x05, 0x12, 0x11,
+	0x0a, 0x0d, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x41, 0x4c, 0x10,
+	0x06, 0x2a, 0x60, 0x0a, 0x11, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x1e, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52,
+	0x59, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x49,
+	0x4e, 0x55, 0x54, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x02,
+	0x12, 0x07, 0x0a, 0x03, 0x44, 0x41, 0x59, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x45, 0x45,
+	0x4b, 0x10, 0x04, 0x2a, 0x6b, 0x0a, 0x0d, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x44, 0x49, 0x52,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x49, 0x4b, 0x45, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x44, 0x49, 0x53, 0x4c, 0x49, 0x4b, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x52,
+	0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x4c, 0x49, 0x4b, 0x45, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e,
+	0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x4c, 0x49, 0x4b, 0x45, 0x10, 0x04,
+	0x2a, 0x4c, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x07, 0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e,
+	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x44, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x03, 0x2a, 0x4f,
+	0x0a, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17,
+	0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x52, 0x45,
+	0x46, 0x49, 0x58, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x54, 0x45,
+	0x58, 0x54, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x55, 0x5a, 0x5a, 0x59, 0x10, 0x03, 0x2a,
+	0x5d, 0x0a, 0x0e, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x52, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x57, 0x49,
+	0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10,
+	0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x02, 0x12,
+	0x0d, 0x0a, 0x09, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x03, 0x2a, 0x4f,
+	0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x53,
+	0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x44, 0x32, 0x35, 0x35, 0x31, 0x39, 0x10, 0x01,
+	0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x50, 0x32, 0x35, 0x36, 0x4b, 0x31, 0x10, 0x02, 0x2a,
+	0x53, 0x0a, 0x0b, 0x46, 0x72, 0x61, 0x75, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x18, 0x46, 0x52, 0x41, 0x55, 0x44, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x46, 0x4c, 0x41, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55,
+	0x4e, 0x54, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x51, 0x55, 0x41, 0x52, 0x41, 0x4e, 0x54, 0x49,
+	0x4e, 0x45, 0x10, 0x03, 0x2a, 0x5a, 0x0a, 0x0f, 0x46, 0x72, 0x61, 0x75, 0x64, 0x43, 0x61, 0x73,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x46, 0x52, 0x41, 0x55, 0x44,
+	0x5f, 0x43, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x50,
+	0x45, 0x4e, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03,
+	0x32, 0xe2, 0x15, 0x0a, 0x0d, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x98,
+	0xb5, 0x18, 0x01, 0x12, 0x43, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x43, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x12, 0x19, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x43,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x12, 0x1a, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x30, 0x01, 0x12, 0x4f, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x98,
+	0xb5, 0x18, 0x01, 0x12, 0x4f, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04,
+	0x98, 0xb5, 0x18, 0x01, 0x12, 0x44, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x12,
+	0x16, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x2e, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x08, 0x98, 0xb5, 0x18, 0x01, 0xa0, 0xb5, 0x18, 0x01, 0x12, 0x4d, 0x0a, 0x0a, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x19, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x08, 0x98, 0xb5, 0x18, 0x01, 0xa0, 0xb5, 0x18, 0x01, 0x12, 0x4d, 0x0a, 0x0a, 0x41, 0x64, 0x64,
+	0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x12, 0x19, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x2e, 0x41, 0x64, 0x64, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x44,
+	0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08,
+	0x98, 0xb5, 0x18, 0x01, 0xa0, 0xb5, 0x18, 0x01, 0x12, 0x56, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x12, 0x1c, 0x2e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x98, 0xb5, 0x18, 0x01, 0xa0, 0xb5, 0x18, 0x01,
+	0x12, 0x43, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x19,
+	0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x6f, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56,
+	0x6f, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x1d, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56,
+	0x6f, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f,
+	0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x30, 0x01, 0x12, 0x58, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x42, 0x79, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x42, 0x79, 0x53,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x42,
+	0x79, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x42, 0x79, 0x53, 0x6c,
+	0x75, 0x67, 0x12, 0x1e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x73, 0x12, 0x1c, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x43, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x73, 0x12, 0x1d, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a,
+	0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x12, 0x1b, 0x2e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x52, 0x0a, 0x0f, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x12, 0x1e, 0x2e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x43,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x43,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61,
+	0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x98, 0xb5, 0x18,
+	0x01, 0x12, 0x4a, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x18,
+	0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x08, 0x98, 0xb5, 0x18, 0x01, 0xa0, 0xb5, 0x18, 0x01, 0x12, 0x55, 0x0a,
+	0x0e, 0x43, 0x61, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x12,
+	0x1d, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x73, 0x74, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04,
+	0x98, 0xb5, 0x18, 0x01, 0x12, 0x55, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x12, 0x18, 0x2e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x1d, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x54, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x12, 0x1c, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x90, 0xb5, 0x18, 0x01, 0x30, 0x01, 0x12, 0x56, 0x0a,
+	0x0d, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x12, 0x1c,
+	0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x90, 0xb5, 0x18,
+	0x01, 0x98, 0xb5, 0x18, 0x01, 0x12, 0x63, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x04, 0x90, 0xb5, 0x18, 0x01, 0x30, 0x01, 0x12, 0x50, 0x0a, 0x0b, 0x50, 0x75,
+	0x72, 0x67, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x12, 0x1a, 0x2e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x50,
+	0x75, 0x72, 0x67, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x08, 0x90, 0xb5, 0x18, 0x01, 0x98, 0xb5, 0x18, 0x01, 0x12, 0x52, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x2e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x90, 0xb5, 0x18, 0x01,
+	0x12, 0x55, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x61, 0x75, 0x64, 0x43, 0x61, 0x73,
+	0x65, 0x73, 0x12, 0x1d, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x72, 0x61, 0x75, 0x64, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x72, 0x61, 0x75, 0x64, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x04, 0x90, 0xb5, 0x18, 0x01, 0x12, 0x5c, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x46, 0x72, 0x61, 0x75, 0x64, 0x43, 0x61, 0x73, 0x65, 0x12, 0x1e, 0x2e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x46, 0x72, 0x61, 0x75, 0x64, 0x43,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x46, 0x72, 0x61, 0x75, 0x64, 0x43,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x90, 0xb5, 0x18,
+	0x01, 0x98, 0xb5, 0x18, 0x01, 0x42, 0x08, 0x5a, 0x06, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_crypto_proto_rawDescOnce sync.Once
+	file_crypto_proto_rawDescData = file_crypto_proto_rawDesc
+)
+
+func file_crypto_proto_rawDescGZIP() []byte {
+	file_crypto_proto_rawDescOnce.Do(func() {
+		file_crypto_proto_rawDescData = protoimpl.X.CompressGZIP(file_crypto_proto_rawDescData)
+	})
+	return file_crypto_proto_rawDescData
+}
+
+var file_crypto_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_crypto_proto_msgTypes = make([]protoimpl.MessageInfo, 84)
+var file_crypto_proto_goTypes = []interface{}{
+	(RankingStrategy)(0),               // 0: crypto.RankingStrategy
+	(HistoryResolution)(0),             // 1: crypto.HistoryResolution
+	(VoteDirection)(0),                 // 2: crypto.VoteDirection
+	(ExportFormat)(0),                  // 3: crypto.ExportFormat
+	(SearchMode)(0),                    // 4: crypto.SearchMode
+	(TrendingWindow)(0),                // 5: crypto.TrendingWindow
+	(SignatureScheme)(0),               // 6: crypto.SignatureScheme
+	(FraudAction)(0),                   // 7: crypto.FraudAction
+	(FraudCaseStatus)(0),               // 8: crypto.FraudCaseStatus
+	(*Crypto)(nil),                     // 9: crypto.Crypto
+	(*CreateCryptoRequest)(nil),        // 10: crypto.CreateCryptoRequest
+	(*CreateCryptoResponse)(nil),       // 11: crypto.CreateCryptoResponse
+	(*ListCryptosRequest)(nil),         // 12: crypto.ListCryptosRequest
+	(*ListCryptosResponse)(nil),        // 13: crypto.ListCryptosResponse
+	(*ReadCryptoRequest)(nil),          // 14: crypto.ReadCryptoRequest
+	(*ReadCryptoResponse)(nil),         // 15: crypto.ReadCryptoResponse
+	(*UpdateCryptoRequest)(nil),        // 16: crypto.UpdateCryptoRequest
+	(*UpdateCryptoResponse)(nil),       // 17: crypto.UpdateCryptoResponse
+	(*DeleteCryptoRequest)(nil),        // 18: crypto.DeleteCryptoRequest
+	(*DeleteCryptoResponse)(nil),       // 19: crypto.DeleteCryptoResponse
+	(*AddLikeRequest)(nil),             // 20: crypto.AddLikeRequest
+	(*AddLikeResponse)(nil),            // 21: crypto.AddLikeResponse
+	(*RemoveLikeRequest)(nil),          // 22: crypto.RemoveLikeRequest
+	(*RemoveLikeResponse)(nil),         // 23: crypto.RemoveLikeResponse
+	(*AddDislikeRequest)(nil),          // 24: crypto.AddDislikeRequest
+	(*AddDislikeResponse)(nil),         // 25: crypto.AddDislikeResponse
+	(*RemoveDislikeRequest)(nil),       // 26: crypto.RemoveDislikeRequest
+	(*RemoveDislikeResponse)(nil),      // 27: crypto.RemoveDislikeResponse
+	(*CountVotesRequest)(nil),          // 28: crypto.CountVotesRequest
+	(*CountVotesResponse)(nil),         // 29: crypto.CountVotesResponse
+	(*VoteTally)(nil),                  // 30: crypto.VoteTally
+	(*GetVoteProofRequest)(nil),        // 31: crypto.GetVoteProofRequest
+	(*GetVoteProofResponse)(nil),       // 32: crypto.GetVoteProofResponse
+	(*ProofStep)(nil),                  // 33: crypto.ProofStep
+	(*GetCryptoStatsRequest)(nil),      // 34: crypto.GetCryptoStatsRequest
+	(*GetCryptoStatsResponse)(nil),     // 35: crypto.GetCryptoStatsResponse
+	(*GetCatalogStatsRequest)(nil),     // 36: crypto.GetCatalogStatsRequest
+	(*GetCatalogStatsResponse)(nil),    // 37: crypto.GetCatalogStatsResponse
+	(*HistogramBucket)(nil),            // 38: crypto.HistogramBucket
+	(*GetVoteHistoryRequest)(nil),      // 39: crypto.GetVoteHistoryRequest
+	(*GetVoteHistoryResponse)(nil),     // 40: crypto.GetVoteHistoryResponse
+	(*VoteHistoryPoint)(nil),           // 41: crypto.VoteHistoryPoint
+	(*FilterByNameRequest)(nil),        // 42: crypto.FilterByNameRequest
+	(*FilterByNameResponse)(nil),       // 43: crypto.FilterByNameResponse
+	(*GetCryptoBySymbolRequest)(nil),   // 44: crypto.GetCryptoBySymbolRequest
+	(*GetCryptoBySymbolResponse)(nil),  // 45: crypto.GetCryptoBySymbolResponse
+	(*GetCryptoBySlugRequest)(nil),     // 46: crypto.GetCryptoBySlugRequest
+	(*GetCryptoBySlugResponse)(nil),    // 47: crypto.GetCryptoBySlugResponse
+	(*RestoreCryptoRequest)(nil),       // 48: crypto.RestoreCryptoRequest
+	(*RestoreCryptoResponse)(nil),      // 49: crypto.RestoreCryptoResponse
+	(*ListDeletedCryptosRequest)(nil),  // 50: crypto.ListDeletedCryptosRequest
+	(*ListDeletedCryptosResponse)(nil), // 51: crypto.ListDeletedCryptosResponse
+	(*PurgeCryptoRequest)(nil),         // 52: crypto.PurgeCryptoRequest
+	(*PurgeCryptoResponse)(nil),        // 53: crypto.PurgeCryptoResponse
+	(*BatchGetCryptosRequest)(nil),     // 54: crypto.BatchGetCryptosRequest
+	(*BatchGetCryptosResponse)(nil),    // 55: crypto.BatchGetCryptosResponse
+	(*BatchGetCryptoResult)(nil),       // 56: crypto.BatchGetCryptoResult
+	(*BatchCreateCryptosRequest)(nil),  // 57: crypto.BatchCreateCryptosRequest
+	(*BatchCreateCryptosResponse)(nil), // 58: crypto.BatchCreateCryptosResponse
+	(*BatchCreateCryptoResult)(nil),    // 59: crypto.BatchCreateCryptoResult
+	(*BatchVoteRequest)(nil),           // 60: crypto.BatchVoteRequest
+	(*Vote)(nil),                       // 61: crypto.Vote
+	(*BatchVoteResponse)(nil),          // 62: crypto.BatchVoteResponse
+	(*BatchVoteResult)(nil),            // 63: crypto.BatchVoteResult
+	(*ImportCryptoItem)(nil),           // 64: crypto.ImportCryptoItem
+	(*ImportCryptosResponse)(nil),      // 65: crypto.ImportCryptosResponse
+	(*ImportRowError)(nil),             // 66: crypto.ImportRowError
+	(*ExportCryptosRequest)(nil),       // 67: crypto.ExportCryptosRequest
+	(*ExportCryptosResponse)(nil),      // 68: crypto.ExportCryptosResponse
+	(*SearchCryptosRequest)(nil),       // 69: crypto.SearchCryptosRequest
+	(*SearchCryptosResponse)(nil),      // 70: crypto.SearchCryptosResponse
+	(*SearchHit)(nil),                  // 71: crypto.SearchHit
+	(*Highlight)(nil),                  // 72: crypto.Highlight
+	(*MatchRange)(nil),                 // 73: crypto.MatchRange
+	(*SuggestCryptosRequest)(nil),      // 74: crypto.SuggestCryptosRequest
+	(*SuggestCryptosResponse)(nil),     // 75: crypto.SuggestCryptosResponse
+	(*Suggestion)(nil),                 // 76: crypto.Suggestion
+	(*ListTrendingRequest)(nil),        // 77: crypto.ListTrendingRequest
+	(*ListTrendingResponse)(nil),       // 78: crypto.ListTrendingResponse
+	(*TrendingCrypto)(nil),             // 79: crypto.TrendingCrypto
+	(*WatchCryptosRequest)(nil),        // 80: crypto.WatchCryptosRequest
+	(*WatchCryptosResponse)(nil),       // 81: crypto.WatchCryptosResponse
+	(*GetCacheStatsRequest)(nil),       // 82: crypto.GetCacheStatsRequest
+	(*GetCacheStatsResponse)(nil),      // 83: crypto.GetCacheStatsResponse
+	(*CastSignedVoteRequest)(nil),      // 84: crypto.CastSignedVoteRequest
+	(*CastSignedVoteResponse)(nil),     // 85: crypto.CastSignedVoteResponse
+	(*FraudCase)(nil),                  // 86: crypto.FraudCase
+	(*ListFraudCasesRequest)(nil),      // 87: crypto.ListFraudCasesRequest
+	(*ListFraudCasesResponse)(nil),     // 88: crypto.ListFraudCasesResponse
+	(*ReviewFraudCaseRequest)(nil),     // 89: crypto.ReviewFraudCaseRequest
+	(*ReviewFraudCaseResponse)(nil),    // 90: crypto.ReviewFraudCaseResponse
+	(*GetVoteChallengeRequest)(nil),    // 91: crypto.GetVoteChallengeRequest
+	(*GetVoteChallengeResponse)(nil),   // 92: crypto.GetVoteChallengeResponse
+	(*timestamppb.Timestamp)(nil),      // 93: google.protobuf.Timestamp
+}
+var file_crypto_proto_depIdxs = []int32{
+	9,  // 0: crypto.CreateCryptoResponse.crypto:type_name -> crypto.Crypto
+	0,  // 1: crypto.ListCryptosRequest.ranking:type_name -> crypto.RankingStrategy
+	9,  // 2: crypto.ListCryptosResponse.crypto:type_name -> crypto.Crypto
+	9,  // 3: crypto.ReadCryptoResponse.crypto:type_name -> crypto.Crypto
+	9,  // 4: crypto.UpdateCryptoResponse.crypto:type_name -> crypto.Crypto
+	9,  // 5: crypto.AddLikeResponse.crypto:type_name -> crypto.Crypto
+	9,  // 6: crypto.RemoveLikeResponse.crypto:type_name -> crypto.Crypto
+	9,  // 7: crypto.AddDislikeResponse.crypto:type_name -> crypto.Crypto
+	9,  // 8: crypto.RemoveDislikeResponse.crypto:type_name -> crypto.Crypto
+	30, // 9: crypto.CountVotesResponse.tally:type_name -> crypto.VoteTally
+	93, // 10: crypto.VoteTally.built_at:type_name -> google.protobuf.Timestamp
+	30, // 11: crypto.GetVoteProofResponse.tally:type_name -> crypto.VoteTally
+	33, // 12: crypto.GetVoteProofResponse.proof:type_name -> crypto.ProofStep
+	9,  // 13: crypto.GetCryptoStatsResponse.crypto:type_name -> crypto.Crypto
+	38, // 14: crypto.GetCatalogStatsResponse.ratio_distribution:type_name -> crypto.HistogramBucket
+	38, // 15: crypto.GetCatalogStatsResponse.vote_distribution:type_name -> crypto.HistogramBucket
+	93, // 16: crypto.GetVoteHistoryRequest.from:type_name -> google.protobuf.Timestamp
+	93, // 17: crypto.GetVoteHistoryRequest.to:type_name -> google.protobuf.Timestamp
+	1,  // 18: crypto.GetVoteHistoryRequest.resolution:type_name -> crypto.HistoryResolution
+	41, // 19: crypto.GetVoteHistoryResponse.points:type_name -> crypto.VoteHistoryPoint
+	93, // 20: crypto.VoteHistoryPoint.bucket:type_name -> google.protobuf.Timestamp
+	9,  // 21: crypto.FilterByNameResponse.crypto:type_name -> crypto.Crypto
+	9,  // 22: crypto.GetCryptoBySymbolResponse.crypto:type_name -> crypto.Crypto
+	9,  // 23: crypto.GetCryptoBySlugResponse.crypto:type_name -> crypto.Crypto
+	9,  // 24: crypto.RestoreCryptoResponse.crypto:type_name -> crypto.Crypto
+	9,  // 25: crypto.ListDeletedCryptosResponse.crypto:type_name -> crypto.Crypto
+	93, // 26: crypto.ListDeletedCryptosResponse.deleted_at:type_name -> google.protobuf.Timestamp
+	56, // 27: crypto.BatchGetCryptosResponse.results:type_name -> crypto.BatchGetCryptoResult
+	9,  // 28: crypto.BatchGetCryptoResult.crypto:type_name -> crypto.Crypto
+	10, // 29: crypto.BatchCreateCryptosRequest.items:type_name -> crypto.CreateCryptoRequest
+	59, // 30: crypto.BatchCreateCryptosResponse.results:type_name -> crypto.BatchCreateCryptoResult
+	9,  // 31: crypto.BatchCreateCryptoResult.crypto:type_name -> crypto.Crypto
+	61, // 32: crypto.BatchVoteRequest.votes:type_name -> crypto.Vote
+	2,  // 33: crypto.Vote.direction:type_name -> crypto.VoteDirection
+	63, // 34: crypto.BatchVoteResponse.results:type_name -> crypto.BatchVoteResult
+	9,  // 35: crypto.BatchVoteResult.crypto:type_name -> crypto.Crypto
+	66, // 36: crypto.ImportCryptosResponse.errors:type_name -> crypto.ImportRowError
+	3,  // 37: crypto.ExportCryptosRequest.format:type_name -> crypto.ExportFormat
+	4,  // 38: crypto.SearchCryptosRequest.mode:type_name -> crypto.SearchMode
+	71, // 39: crypto.SearchCryptosResponse.hits:type_name -> crypto.SearchHit
+	9,  // 40: crypto.SearchHit.crypto:type_name -> crypto.Crypto
+	72, // 41: crypto.SearchHit.highlights:type_name -> crypto.Highlight
+	73, // 42: crypto.Highlight.matches:type_name -> crypto.MatchRange
+	76, // 43: crypto.SuggestCryptosResponse.suggestions:type_name -> crypto.Suggestion
+	5,  // 44: crypto.ListTrendingRequest.window:type_name -> crypto.TrendingWindow
+	79, // 45: crypto.ListTrendingResponse.cryptos:type_name -> crypto.TrendingCrypto
+	9,  // 46: crypto.TrendingCrypto.crypto:type_name -> crypto.Crypto
+	9,  // 47: crypto.WatchCryptosResponse.crypto:type_name -> crypto.Crypto
+	93, // 48: crypto.WatchCryptosResponse.occurred_at:type_name -> google.protobuf.Timestamp
+	2,  // 49: crypto.CastSignedVoteRequest.direction:type_name -> crypto.VoteDirection
+	6,  // 50: crypto.CastSignedVoteRequest.scheme:type_name -> crypto.SignatureScheme
+	9,  // 51: crypto.CastSignedVoteResponse.crypto:type_name -> crypto.Crypto
+	7,  // 52: crypto.FraudCase.action:type_name -> crypto.FraudAction
+	8,  // 53: crypto.FraudCase.status:type_name -> crypto.FraudCaseStatus
+	93, // 54: crypto.FraudCase.quarantined_until:type_name -> google.protobuf.Timestamp
+	93, // 55: crypto.FraudCase.created_at:type_name -> google.protobuf.Timestamp
+	93, // 56: crypto.FraudCase.reviewed_at:type_name -> google.protobuf.Timestamp
+	8,  // 57: crypto.ListFraudCasesRequest.status:type_name -> crypto.FraudCaseStatus
+	86, // 58: crypto.ListFraudCasesResponse.cases:type_name -> crypto.FraudCase
+	8,  // 59: crypto.ReviewFraudCaseRequest.status:type_name -> crypto.FraudCaseStatus
+	86, // 60: crypto.ReviewFraudCaseResponse.case:type_name -> crypto.FraudCase
+	9,  // 61: crypto.ReviewFraudCaseResponse.crypto:type_name -> crypto.Crypto
+	93, // 62: crypto.GetVoteChallengeResponse.expires_at:type_name -> google.protobuf.Timestamp
+	10, // 63: crypto.CryptoService.CreateCrypto:input_type -> crypto.CreateCryptoRequest
+	14, // 64: crypto.CryptoService.ReadCrypto:input_type -> crypto.ReadCryptoRequest
+	12, // 65: crypto.CryptoService.ListCryptos:input_type -> crypto.ListCryptosRequest
+	16, // 66: crypto.CryptoService.UpdateCrypto:input_type -> crypto.UpdateCryptoRequest
+	18, // 67: crypto.CryptoService.DeleteCrypto:input_type -> crypto.DeleteCryptoRequest
+	20, // 68: crypto.CryptoService.AddLike:input_type -> crypto.AddLikeRequest
+	22, // 69: crypto.CryptoService.RemoveLike:input_type -> crypto.RemoveLikeRequest
+	24, // 70: crypto.CryptoService.AddDislike:input_type -> crypto.AddDislikeRequest
+	26, // 71: crypto.CryptoService.RemoveDislike:input_type -> crypto.RemoveDislikeRequest
+	28, // 72: crypto.CryptoService.CountVotes:input_type -> crypto.CountVotesRequest
+	31, // 73: crypto.CryptoService.GetVoteProof:input_type -> crypto.GetVoteProofRequest
+	39, // 74: crypto.CryptoService.GetVoteHistory:input_type -> crypto.GetVoteHistoryRequest
+	34, // 75: crypto.CryptoService.GetCryptoStats:input_type -> crypto.GetCryptoStatsRequest
+	36, // 76: crypto.CryptoService.GetCatalogStats:input_type -> crypto.GetCatalogStatsRequest
+	42, // 77: crypto.CryptoService.FilterByName:input_type -> crypto.FilterByNameRequest
+	44, // 78: crypto.CryptoService.GetCryptoBySymbol:input_type -> crypto.GetCryptoBySymbolRequest
+	46, // 79: crypto.CryptoService.GetCryptoBySlug:input_type -> crypto.GetCryptoBySlugRequest
+	69, // 80: crypto.CryptoService.SearchCryptos:input_type -> crypto.SearchCryptosRequest
+	74, // 81: crypto.CryptoService.SuggestCryptos:input_type -> crypto.SuggestCryptosRequest
+	77, // 82: crypto.CryptoService.ListTrending:input_type -> crypto.ListTrendingRequest
+	80, // 83: crypto.CryptoService.WatchCryptos:input_type -> crypto.WatchCryptosRequest
+	54, // 84: crypto.CryptoService.BatchGetCryptos:input_type -> crypto.BatchGetCryptosRequest
+	57, // 85: crypto.CryptoService.BatchCreateCryptos:input_type -> crypto.BatchCreateCryptosRequest
+	60, // 86: crypto.CryptoService.BatchVote:input_type -> crypto.BatchVoteRequest
+	84, // 87: crypto.CryptoService.CastSignedVote:input_type -> crypto.CastSignedVoteRequest
+	91, // 88: crypto.CryptoService.GetVoteChallenge:input_type -> crypto.GetVoteChallengeRequest
+	64, // 89: crypto.CryptoService.ImportCryptos:input_type -> crypto.ImportCryptoItem
+	67, // 90: crypto.CryptoService.ExportCryptos:input_type -> crypto.ExportCryptosRequest
+	48, // 91: crypto.CryptoService.RestoreCrypto:input_type -> crypto.RestoreCryptoRequest
+	50, // 92: crypto.CryptoService.ListDeletedCryptos:input_type -> crypto.ListDeletedCryptosRequest
+	52, // 93: crypto.CryptoService.PurgeCrypto:input_type -> crypto.PurgeCryptoRequest
+	82, // 94: crypto.CryptoService.GetCacheStats:input_type -> crypto.GetCacheStatsRequest
+	87, // 95: crypto.CryptoService.ListFraudCases:input_type -> crypto.ListFraudCasesRequest
+	89, // 96: crypto.CryptoService.ReviewFraudCase:input_type -> crypto.ReviewFraudCaseRequest
+	11, // 97: crypto.CryptoService.CreateCrypto:output_type -> crypto.CreateCryptoResponse
+	15, // 98: crypto.CryptoService.ReadCrypto:output_type -> crypto.ReadCryptoResponse
+	13, // 99: crypto.CryptoService.ListCryptos:output_type -> crypto.ListCryptosResponse
+	17, // 100: crypto.CryptoService.UpdateCrypto:output_type -> crypto.UpdateCryptoResponse
+	19, // 101: crypto.CryptoService.DeleteCrypto:output_type -> crypto.DeleteCryptoResponse
+	21, // 102: crypto.CryptoService.AddLike:output_type -> crypto.AddLikeResponse
+	23, // 103: crypto.CryptoService.RemoveLike:output_type -> crypto.RemoveLikeResponse
+	25, // 104: crypto.CryptoService.AddDislike:output_type -> crypto.AddDislikeResponse
+	27, // 105: crypto.CryptoService.RemoveDislike:output_type -> crypto.RemoveDislikeResponse
+	29, // 106: crypto.CryptoService.CountVotes:output_type -> crypto.CountVotesResponse
+	32, // 107: crypto.CryptoService.GetVoteProof:output_type -> crypto.GetVoteProofResponse
+	40, // 108: crypto.CryptoService.GetVoteHistory:output_type -> crypto.GetVoteHistoryResponse
+	35, // 109: crypto.CryptoService.GetCryptoStats:output_type -> crypto.GetCryptoStatsResponse
+	37, // 110: crypto.CryptoService.GetCatalogStats:output_type -> crypto.GetCatalogStatsResponse
+	9,  // 111: crypto.CryptoService.FilterByName:output_type -> crypto.Crypto
+	45, // 112: crypto.CryptoService.GetCryptoBySymbol:output_type -> crypto.GetCryptoBySymbolResponse
+	47, // 113: crypto.CryptoService.GetCryptoBySlug:output_type -> crypto.GetCryptoBySlugResponse
+	70, // 114: crypto.CryptoService.SearchCryptos:output_type -> crypto.SearchCryptosResponse
+	75, // 115: crypto.CryptoService.SuggestCryptos:output_type -> crypto.SuggestCryptosResponse
+	78, // 116: crypto.CryptoService.ListTrending:output_type -> crypto.ListTrendingResponse
+	81, // 117: crypto.CryptoService.WatchCryptos:output_type -> crypto.WatchCryptosResponse
+	55, // 118: crypto.CryptoService.BatchGetCryptos:output_type -> crypto.BatchGetCryptosResponse
+	58, // 119: crypto.CryptoService.BatchCreateCryptos:output_type -> crypto.BatchCreateCryptosResponse
+	62, // 120: crypto.CryptoService.BatchVote:output_type -> crypto.BatchVoteResponse
+	85, // 121: crypto.CryptoService.CastSignedVote:output_type -> crypto.CastSignedVoteResponse
+	92, // 122: crypto.CryptoService.GetVoteChallenge:output_type -> crypto.GetVoteChallengeResponse
+	65, // 123: crypto.CryptoService.ImportCryptos:output_type -> crypto.ImportCryptosResponse
+	68, // 124: crypto.CryptoService.ExportCryptos:output_type -> crypto.ExportCryptosResponse
+	49, // 125: crypto.CryptoService.RestoreCrypto:output_type -> crypto.RestoreCryptoResponse
+	51, // 126: crypto.CryptoService.ListDeletedCryptos:output_type -> crypto.ListDeletedCryptosResponse
+	53, // 127: crypto.CryptoService.PurgeCrypto:output_type -> crypto.PurgeCryptoResponse
+	83, // 128: crypto.CryptoService.GetCacheStats:output_type -> crypto.GetCacheStatsResponse
+	88, // 129: crypto.CryptoService.ListFraudCases:output_type -> crypto.ListFraudCasesResponse
+	90, // 130: crypto.CryptoService.ReviewFraudCase:output_type -> crypto.ReviewFraudCaseResponse
+	97, // [97:131] is the sub-list for method output_type
+	63, // [63:97] is the sub-list for method input_type
+	63, // [63:63] is the sub-list for extension type_name
+	63, // [63:63] is the sub-list for extension extendee
+	0,  // [0:63] is the sub-list for field type_name
+}
+
+func init() { file_crypto_proto_init() }
+func file_crypto_proto_init() {
+	if File_crypto_proto != nil {
+		return
+	}
+	file_auth_proto_init()
+	file_challenge_proto_init()
+	file_idempotency_proto_init()
+	file_validate_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_crypto_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Crypto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCryptoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCryptoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCryptosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCryptosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadCryptoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadCryptoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCryptoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCryptoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCryptoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCryptoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddLikeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddLikeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveLikeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveLikeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddDislikeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddDislikeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveDislikeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveDislikeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountVotesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountVotesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteTally); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVoteProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVoteProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProofStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCryptoStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCryptoStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCatalogStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCatalogStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistogramBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVoteHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVoteHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteHistoryPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilterByNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilterByNameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCryptoBySymbolRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCryptoBySymbolResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCryptoBySlugRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCryptoBySlugResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreCryptoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreCryptoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeletedCryptosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeletedCryptosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeCryptoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeCryptoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetCryptosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetCryptosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetCryptoResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1409,8 +7197,8 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCryptoRequest); i {
+		file_crypto_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateCryptosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1421,8 +7209,8 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCryptoResponse); i {
+		file_crypto_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateCryptosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1433,8 +7221,8 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCryptosRequest); i {
+		file_crypto_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateCryptoResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1445,8 +7233,8 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCryptosResponse); i {
+		file_crypto_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchVoteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1457,8 +7245,8 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadCryptoRequest); i {
+		file_crypto_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Vote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1469,8 +7257,8 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadCryptoResponse); i {
+		file_crypto_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchVoteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1481,8 +7269,8 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCryptoRequest); i {
+		file_crypto_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchVoteResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1493,8 +7281,8 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCryptoResponse); i {
+		file_crypto_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportCryptoItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1505,8 +7293,8 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCryptoRequest); i {
+		file_crypto_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportCryptosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1517,8 +7305,8 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCryptoResponse); i {
+		file_crypto_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportRowError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1529,8 +7317,8 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddLikeRequest); i {
+		file_crypto_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportCryptosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1541,8 +7329,8 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddLikeResponse); i {
+		file_crypto_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportCryptosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1553,8 +7341,8 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveLikeRequest); i {
+		file_crypto_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchCryptosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1565,8 +7353,8 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveLikeResponse); i {
+		file_crypto_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchCryptosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1577,8 +7365,8 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddDislikeRequest); i {
+		file_crypto_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchHit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1589,8 +7377,8 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddDislikeResponse); i {
+		file_crypto_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Highlight); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1601,8 +7389,8 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveDislikeRequest); i {
+		file_crypto_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1613,8 +7401,8 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveDislikeResponse); i {
+		file_crypto_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestCryptosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1625,8 +7413,8 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountVotesRequest); i {
+		file_crypto_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestCryptosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1637,8 +7425,8 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountVotesResponse); i {
+		file_crypto_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Suggestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1649,8 +7437,8 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilterByNameRequest); i {
+		file_crypto_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTrendingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1661,8 +7449,176 @@ func file_crypto_proto_init() {
 				return nil
 			}
 		}
-		file_crypto_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilterByNameResponse); i {
+		file_crypto_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTrendingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrendingCrypto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchCryptosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchCryptosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCacheStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCacheStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CastSignedVoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CastSignedVoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FraudCase); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFraudCasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFraudCasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewFraudCaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewFraudCaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVoteChallengeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVoteChallengeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1674,18 +7630,20 @@ func file_crypto_proto_init() {
 			}
 		}
 	}
+	file_crypto_proto_msgTypes[29].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_crypto_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   23,
+			NumEnums:      9,
+			NumMessages:   84,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_crypto_proto_goTypes,
 		DependencyIndexes: file_crypto_proto_depIdxs,
+		EnumInfos:         file_crypto_proto_enumTypes,
 		MessageInfos:      file_crypto_proto_msgTypes,
 	}.Build()
 	File_crypto_proto = out.File
@@ -28,7 +28,31 @@ type CryptoServiceClient interface {
 	AddDislike(ctx context.Context, in *AddDislikeRequest, opts ...grpc.CallOption) (*AddDislikeResponse, error)
 	RemoveDislike(ctx context.Context, in *RemoveDislikeRequest, opts ...grpc.CallOption) (*RemoveDislikeResponse, error)
 	CountVotes(ctx context.Context, in *CountVotesRequest, opts ...grpc.CallOption) (*CountVotesResponse, error)
+	GetVoteProof(ctx context.Context, in *GetVoteProofRequest, opts ...grpc.CallOption) (*GetVoteProofResponse, error)
+	GetVoteHistory(ctx context.Context, in *GetVoteHistoryRequest, opts ...grpc.CallOption) (*GetVoteHistoryResponse, error)
+	GetCryptoStats(ctx context.Context, in *GetCryptoStatsRequest, opts ...grpc.CallOption) (*GetCryptoStatsResponse, error)
+	GetCatalogStats(ctx context.Context, in *GetCatalogStatsRequest, opts ...grpc.CallOption) (*GetCatalogStatsResponse, error)
+	// Deprecated: use SearchCryptos. The name is matched literally, not as a regex.
 	FilterByName(ctx context.Context, in *FilterByNameRequest, opts ...grpc.CallOption) (CryptoService_FilterByNameClient, error)
+	GetCryptoBySymbol(ctx context.Context, in *GetCryptoBySymbolRequest, opts ...grpc.CallOption) (*GetCryptoBySymbolResponse, error)
+	GetCryptoBySlug(ctx context.Context, in *GetCryptoBySlugRequest, opts ...grpc.CallOption) (*GetCryptoBySlugResponse, error)
+	SearchCryptos(ctx context.Context, in *SearchCryptosRequest, opts ...grpc.CallOption) (*SearchCryptosResponse, error)
+	SuggestCryptos(ctx context.Context, in *SuggestCryptosRequest, opts ...grpc.CallOption) (*SuggestCryptosResponse, error)
+	ListTrending(ctx context.Context, in *ListTrendingRequest, opts ...grpc.CallOption) (*ListTrendingResponse, error)
+	WatchCryptos(ctx context.Context, in *WatchCryptosRequest, opts ...grpc.CallOption) (CryptoService_WatchCryptosClient, error)
+	BatchGetCryptos(ctx context.Context, in *BatchGetCryptosRequest, opts ...grpc.CallOption) (*BatchGetCryptosResponse, error)
+	BatchCreateCryptos(ctx context.Context, in *BatchCreateCryptosRequest, opts ...grpc.CallOption) (*BatchCreateCryptosResponse, error)
+	BatchVote(ctx context.Context, in *BatchVoteRequest, opts ...grpc.CallOption) (*BatchVoteResponse, error)
+	CastSignedVote(ctx context.Context, in *CastSignedVoteRequest, opts ...grpc.CallOption) (*CastSignedVoteResponse, error)
+	GetVoteChallenge(ctx context.Context, in *GetVoteChallengeRequest, opts ...grpc.CallOption) (*GetVoteChallengeResponse, error)
+	ImportCryptos(ctx context.Context, opts ...grpc.CallOption) (CryptoService_ImportCryptosClient, error)
+	ExportCryptos(ctx context.Context, in *ExportCryptosRequest, opts ...grpc.CallOption) (CryptoService_ExportCryptosClient, error)
+	RestoreCrypto(ctx context.Context, in *RestoreCryptoRequest, opts ...grpc.CallOption) (*RestoreCryptoResponse, error)
+	ListDeletedCryptos(ctx context.Context, in *ListDeletedCryptosRequest, opts ...grpc.CallOption) (CryptoService_ListDeletedCryptosClient, error)
+	PurgeCrypto(ctx context.Context, in *PurgeCryptoRequest, opts ...grpc.CallOption) (*PurgeCryptoResponse, error)
+	GetCacheStats(ctx context.Context, in *GetCacheStatsRequest, opts ...grpc.CallOption) (*GetCacheStatsResponse, error)
+	ListFraudCases(ctx context.Context, in *ListFraudCasesRequest, opts ...grpc.CallOption) (*ListFraudCasesResponse, error)
+	ReviewFraudCase(ctx context.Context, in *ReviewFraudCaseRequest, opts ...grpc.CallOption) (*ReviewFraudCaseResponse, error)
 }
 
 type cryptoServiceClient struct {
@@ -152,6 +176,42 @@ func (c *cryptoServiceClient) CountVotes(ctx context.Context, in *CountVotesRequ
 	return out, nil
 }
 
+func (c *cryptoServiceClient) GetVoteProof(ctx context.Context, in *GetVoteProofRequest, opts ...grpc.CallOption) (*GetVoteProofResponse, error) {
+	out := new(GetVoteProofResponse)
+	err := c.cc.Invoke(ctx, "/crypto.CryptoService/GetVoteProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoServiceClient) GetVoteHistory(ctx context.Context, in *GetVoteHistoryRequest, opts ...grpc.CallOption) (*GetVoteHistoryResponse, error) {
+	out := new(GetVoteHistoryResponse)
+	err := c.cc.Invoke(ctx, "/crypto.CryptoService/GetVoteHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoServiceClient) GetCryptoStats(ctx context.Context, in *GetCryptoStatsRequest, opts ...grpc.CallOption) (*GetCryptoStatsResponse, error) {
+	out := new(GetCryptoStatsResponse)
+	err := c.cc.Invoke(ctx, "/crypto.CryptoService/GetCryptoStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoServiceClient) GetCatalogStats(ctx context.Context, in *GetCatalogStatsRequest, opts ...grpc.CallOption) (*GetCatalogStatsResponse, error) {
+	out := new(GetCatalogStatsResponse)
+	err := c.cc.Invoke(ctx, "/crypto.CryptoService/GetCatalogStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cryptoServiceClient) FilterByName(ctx context.Context, in *FilterByNameRequest, opts ...grpc.CallOption) (CryptoService_FilterByNameClient, error) {
 	stream, err := c.cc.NewStream(ctx, &CryptoService_ServiceDesc.Streams[1], "/crypto.CryptoService/FilterByName", opts...)
 	if err != nil {
@@ -184,6 +244,271 @@ func (x *cryptoServiceFilterByNameClient) Recv() (*Crypto, error) {
 	return m, nil
 }
 
+func (c *cryptoServiceClient) GetCryptoBySymbol(ctx context.Context, in *GetCryptoBySymbolRequest, opts ...grpc.CallOption) (*GetCryptoBySymbolResponse, error) {
+	out := new(GetCryptoBySymbolResponse)
+	err := c.cc.Invoke(ctx, "/crypto.CryptoService/GetCryptoBySymbol", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoServiceClient) GetCryptoBySlug(ctx context.Context, in *GetCryptoBySlugRequest, opts ...grpc.CallOption) (*GetCryptoBySlugResponse, error) {
+	out := new(GetCryptoBySlugResponse)
+	err := c.cc.Invoke(ctx, "/crypto.CryptoService/GetCryptoBySlug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoServiceClient) SearchCryptos(ctx context.Context, in *SearchCryptosRequest, opts ...grpc.CallOption) (*SearchCryptosResponse, error) {
+	out := new(SearchCryptosResponse)
+	err := c.cc.Invoke(ctx, "/crypto.CryptoService/SearchCryptos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoServiceClient) SuggestCryptos(ctx context.Context, in *SuggestCryptosRequest, opts ...grpc.CallOption) (*SuggestCryptosResponse, error) {
+	out := new(SuggestCryptosResponse)
+	err := c.cc.Invoke(ctx, "/crypto.CryptoService/SuggestCryptos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoServiceClient) ListTrending(ctx context.Context, in *ListTrendingRequest, opts ...grpc.CallOption) (*ListTrendingResponse, error) {
+	out := new(ListTrendingResponse)
+	err := c.cc.Invoke(ctx, "/crypto.CryptoService/ListTrending", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoServiceClient) WatchCryptos(ctx context.Context, in *WatchCryptosRequest, opts ...grpc.CallOption) (CryptoService_WatchCryptosClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CryptoService_ServiceDesc.Streams[2], "/crypto.CryptoService/WatchCryptos", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cryptoServiceWatchCryptosClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CryptoService_WatchCryptosClient interface {
+	Recv() (*WatchCryptosResponse, error)
+	grpc.ClientStream
+}
+
+type cryptoServiceWatchCryptosClient struct {
+	grpc.ClientStream
+}
+
+func (x *cryptoServiceWatchCryptosClient) Recv() (*WatchCryptosResponse, error) {
+	m := new(WatchCryptosResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cryptoServiceClient) BatchGetCryptos(ctx context.Context, in *BatchGetCryptosRequest, opts ...grpc.CallOption) (*BatchGetCryptosResponse, error) {
+	out := new(BatchGetCryptosResponse)
+	err := c.cc.Invoke(ctx, "/crypto.CryptoService/BatchGetCryptos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoServiceClient) BatchCreateCryptos(ctx context.Context, in *BatchCreateCryptosRequest, opts ...grpc.CallOption) (*BatchCreateCryptosResponse, error) {
+	out := new(BatchCreateCryptosResponse)
+	err := c.cc.Invoke(ctx, "/crypto.CryptoService/BatchCreateCryptos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoServiceClient) BatchVote(ctx context.Context, in *BatchVoteRequest, opts ...grpc.CallOption) (*BatchVoteResponse, error) {
+	out := new(BatchVoteResponse)
+	err := c.cc.Invoke(ctx, "/crypto.CryptoService/BatchVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoServiceClient) CastSignedVote(ctx context.Context, in *CastSignedVoteRequest, opts ...grpc.CallOption) (*CastSignedVoteResponse, error) {
+	out := new(CastSignedVoteResponse)
+	err := c.cc.Invoke(ctx, "/crypto.CryptoService/CastSignedVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoServiceClient) GetVoteChallenge(ctx context.Context, in *GetVoteChallengeRequest, opts ...grpc.CallOption) (*GetVoteChallengeResponse, error) {
+	out := new(GetVoteChallengeResponse)
+	err := c.cc.Invoke(ctx, "/crypto.CryptoService/GetVoteChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoServiceClient) ImportCryptos(ctx context.Context, opts ...grpc.CallOption) (CryptoService_ImportCryptosClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CryptoService_ServiceDesc.Streams[3], "/crypto.CryptoService/ImportCryptos", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cryptoServiceImportCryptosClient{stream}
+	return x, nil
+}
+
+type CryptoService_ImportCryptosClient interface {
+	Send(*ImportCryptoItem) error
+	CloseAndRecv() (*ImportCryptosResponse, error)
+	grpc.ClientStream
+}
+
+type cryptoServiceImportCryptosClient struct {
+	grpc.ClientStream
+}
+
+func (x *cryptoServiceImportCryptosClient) Send(m *ImportCryptoItem) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *cryptoServiceImportCryptosClient) CloseAndRecv() (*ImportCryptosResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportCryptosResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cryptoServiceClient) ExportCryptos(ctx context.Context, in *ExportCryptosRequest, opts ...grpc.CallOption) (CryptoService_ExportCryptosClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CryptoService_ServiceDesc.Streams[4], "/crypto.CryptoService/ExportCryptos", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cryptoServiceExportCryptosClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CryptoService_ExportCryptosClient interface {
+	Recv() (*ExportCryptosResponse, error)
+	grpc.ClientStream
+}
+
+type cryptoServiceExportCryptosClient struct {
+	grpc.ClientStream
+}
+
+func (x *cryptoServiceExportCryptosClient) Recv() (*ExportCryptosResponse, error) {
+	m := new(ExportCryptosResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cryptoServiceClient) RestoreCrypto(ctx context.Context, in *RestoreCryptoRequest, opts ...grpc.CallOption) (*RestoreCryptoResponse, error) {
+	out := new(RestoreCryptoResponse)
+	err := c.cc.Invoke(ctx, "/crypto.CryptoService/RestoreCrypto", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoServiceClient) ListDeletedCryptos(ctx context.Context, in *ListDeletedCryptosRequest, opts ...grpc.CallOption) (CryptoService_ListDeletedCryptosClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CryptoService_ServiceDesc.Streams[5], "/crypto.CryptoService/ListDeletedCryptos", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cryptoServiceListDeletedCryptosClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CryptoService_ListDeletedCryptosClient interface {
+	Recv() (*ListDeletedCryptosResponse, error)
+	grpc.ClientStream
+}
+
+type cryptoServiceListDeletedCryptosClient struct {
+	grpc.ClientStream
+}
+
+func (x *cryptoServiceListDeletedCryptosClient) Recv() (*ListDeletedCryptosResponse, error) {
+	m := new(ListDeletedCryptosResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cryptoServiceClient) PurgeCrypto(ctx context.Context, in *PurgeCryptoRequest, opts ...grpc.CallOption) (*PurgeCryptoResponse, error) {
+	out := new(PurgeCryptoResponse)
+	err := c.cc.Invoke(ctx, "/crypto.CryptoService/PurgeCrypto", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoServiceClient) GetCacheStats(ctx context.Context, in *GetCacheStatsRequest, opts ...grpc.CallOption) (*GetCacheStatsResponse, error) {
+	out := new(GetCacheStatsResponse)
+	err := c.cc.Invoke(ctx, "/crypto.CryptoService/GetCacheStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoServiceClient) ListFraudCases(ctx context.Context, in *ListFraudCasesRequest, opts ...grpc.CallOption) (*ListFraudCasesResponse, error) {
+	out := new(ListFraudCasesResponse)
+	err := c.cc.Invoke(ctx, "/crypto.CryptoService/ListFraudCases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoServiceClient) ReviewFraudCase(ctx context.Context, in *ReviewFraudCaseRequest, opts ...grpc.CallOption) (*ReviewFraudCaseResponse, error) {
+	out := new(ReviewFraudCaseResponse)
+	err := c.cc.Invoke(ctx, "/crypto.CryptoService/ReviewFraudCase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CryptoServiceServer is the server API for CryptoService service.
 // All implementations must embed UnimplementedCryptoServiceServer
 // for forward compatibility
@@ -198,7 +523,31 @@ type CryptoServiceServer interface {
 	AddDislike(context.Context, *AddDislikeRequest) (*AddDislikeResponse, error)
 	RemoveDislike(context.Context, *RemoveDislikeRequest) (*RemoveDislikeResponse, error)
 	CountVotes(context.Context, *CountVotesRequest) (*CountVotesResponse, error)
+	GetVoteProof(context.Context, *GetVoteProofRequest) (*GetVoteProofResponse, error)
+	GetVoteHistory(context.Context, *GetVoteHistoryRequest) (*GetVoteHistoryResponse, error)
+	GetCryptoStats(context.Context, *GetCryptoStatsRequest) (*GetCryptoStatsResponse, error)
+	GetCatalogStats(context.Context, *GetCatalogStatsRequest) (*GetCatalogStatsResponse, error)
+	// Deprecated: use SearchCryptos. The name is matched literally, not as a regex.
 	FilterByName(*FilterByNameRequest, CryptoService_FilterByNameServer) error
+	GetCryptoBySymbol(context.Context, *GetCryptoBySymbolRequest) (*GetCryptoBySymbolResponse, error)
+	GetCryptoBySlug(context.Context, *GetCryptoBySlugRequest) (*GetCryptoBySlugResponse, error)
+	SearchCryptos(context.Context, *SearchCryptosRequest) (*SearchCryptosResponse, error)
+	SuggestCryptos(context.Context, *SuggestCryptosRequest) (*SuggestCryptosResponse, error)
+	ListTrending(context.Context, *ListTrendingRequest) (*ListTrendingResponse, error)
+	WatchCryptos(*WatchCryptosRequest, CryptoService_WatchCryptosServer) error
+	BatchGetCryptos(context.Context, *BatchGetCryptosRequest) (*BatchGetCryptosResponse, error)
+	BatchCreateCryptos(context.Context, *BatchCreateCryptosRequest) (*BatchCreateCryptosResponse, error)
+	BatchVote(context.Context, *BatchVoteRequest) (*BatchVoteResponse, error)
+	CastSignedVote(context.Context, *CastSignedVoteRequest) (*CastSignedVoteResponse, error)
+	GetVoteChallenge(context.Context, *GetVoteChallengeRequest) (*GetVoteChallengeResponse, error)
+	ImportCryptos(CryptoService_ImportCryptosServer) error
+	ExportCryptos(*ExportCryptosRequest, CryptoService_ExportCryptosServer) error
+	RestoreCrypto(context.Context, *RestoreCryptoRequest) (*RestoreCryptoResponse, error)
+	ListDeletedCryptos(*ListDeletedCryptosRequest, CryptoService_ListDeletedCryptosServer) error
+	PurgeCrypto(context.Context, *PurgeCryptoRequest) (*PurgeCryptoResponse, error)
+	GetCacheStats(context.Context, *GetCacheStatsRequest) (*GetCacheStatsResponse, error)
+	ListFraudCases(context.Context, *ListFraudCasesRequest) (*ListFraudCasesResponse, error)
+	ReviewFraudCase(context.Context, *ReviewFraudCaseRequest) (*ReviewFraudCaseResponse, error)
 	mustEmbedUnimplementedCryptoServiceServer()
 }
 
@@ -236,9 +585,78 @@ func (UnimplementedCryptoServiceServer) RemoveDislike(context.Context, *RemoveDi
 func (UnimplementedCryptoServiceServer) CountVotes(context.Context, *CountVotesRequest) (*CountVotesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CountVotes not implemented")
 }
+func (UnimplementedCryptoServiceServer) GetVoteProof(context.Context, *GetVoteProofRequest) (*GetVoteProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVoteProof not implemented")
+}
+func (UnimplementedCryptoServiceServer) GetVoteHistory(context.Context, *GetVoteHistoryRequest) (*GetVoteHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVoteHistory not implemented")
+}
+func (UnimplementedCryptoServiceServer) GetCryptoStats(context.Context, *GetCryptoStatsRequest) (*GetCryptoStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCryptoStats not implemented")
+}
+func (UnimplementedCryptoServiceServer) GetCatalogStats(context.Context, *GetCatalogStatsRequest) (*GetCatalogStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCatalogStats not implemented")
+}
 func (UnimplementedCryptoServiceServer) FilterByName(*FilterByNameRequest, CryptoService_FilterByNameServer) error {
 	return status.Errorf(codes.Unimplemented, "method FilterByName not implemented")
 }
+func (UnimplementedCryptoServiceServer) GetCryptoBySymbol(context.Context, *GetCryptoBySymbolRequest) (*GetCryptoBySymbolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCryptoBySymbol not implemented")
+}
+func (UnimplementedCryptoServiceServer) GetCryptoBySlug(context.Context, *GetCryptoBySlugRequest) (*GetCryptoBySlugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCryptoBySlug not implemented")
+}
+func (UnimplementedCryptoServiceServer) SearchCryptos(context.Context, *SearchCryptosRequest) (*SearchCryptosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCryptos not implemented")
+}
+func (UnimplementedCryptoServiceServer) SuggestCryptos(context.Context, *SuggestCryptosRequest) (*SuggestCryptosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestCryptos not implemented")
+}
+func (UnimplementedCryptoServiceServer) ListTrending(context.Context, *ListTrendingRequest) (*ListTrendingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrending not implemented")
+}
+func (UnimplementedCryptoServiceServer) WatchCryptos(*WatchCryptosRequest, CryptoService_WatchCryptosServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCryptos not implemented")
+}
+func (UnimplementedCryptoServiceServer) BatchGetCryptos(context.Context, *BatchGetCryptosRequest) (*BatchGetCryptosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetCryptos not implemented")
+}
+func (UnimplementedCryptoServiceServer) BatchCreateCryptos(context.Context, *BatchCreateCryptosRequest) (*BatchCreateCryptosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateCryptos not implemented")
+}
+func (UnimplementedCryptoServiceServer) BatchVote(context.Context, *BatchVoteRequest) (*BatchVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchVote not implemented")
+}
+func (UnimplementedCryptoServiceServer) CastSignedVote(context.Context, *CastSignedVoteRequest) (*CastSignedVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CastSignedVote not implemented")
+}
+func (UnimplementedCryptoServiceServer) GetVoteChallenge(context.Context, *GetVoteChallengeRequest) (*GetVoteChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVoteChallenge not implemented")
+}
+func (UnimplementedCryptoServiceServer) ImportCryptos(CryptoService_ImportCryptosServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportCryptos not implemented")
+}
+func (UnimplementedCryptoServiceServer) ExportCryptos(*ExportCryptosRequest, CryptoService_ExportCryptosServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportCryptos not implemented")
+}
+func (UnimplementedCryptoServiceServer) RestoreCrypto(context.Context, *RestoreCryptoRequest) (*RestoreCryptoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCrypto not implemented")
+}
+func (UnimplementedCryptoServiceServer) ListDeletedCryptos(*ListDeletedCryptosRequest, CryptoService_ListDeletedCryptosServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListDeletedCryptos not implemented")
+}
+func (UnimplementedCryptoServiceServer) PurgeCrypto(context.Context, *PurgeCryptoRequest) (*PurgeCryptoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeCrypto not implemented")
+}
+func (UnimplementedCryptoServiceServer) GetCacheStats(context.Context, *GetCacheStatsRequest) (*GetCacheStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCacheStats not implemented")
+}
+func (UnimplementedCryptoServiceServer) ListFraudCases(context.Context, *ListFraudCasesRequest) (*ListFraudCasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFraudCases not implemented")
+}
+func (UnimplementedCryptoServiceServer) ReviewFraudCase(context.Context, *ReviewFraudCaseRequest) (*ReviewFraudCaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewFraudCase not implemented")
+}
 func (UnimplementedCryptoServiceServer) mustEmbedUnimplementedCryptoServiceServer() {}
 
 // UnsafeCryptoServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -435,27 +853,458 @@ func _CryptoService_CountVotes_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CryptoService_FilterByName_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(FilterByNameRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _CryptoService_GetVoteProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVoteProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(CryptoServiceServer).FilterByName(m, &cryptoServiceFilterByNameServer{stream})
-}
-
-type CryptoService_FilterByNameServer interface {
-	Send(*Crypto) error
-	grpc.ServerStream
-}
-
-type cryptoServiceFilterByNameServer struct {
-	grpc.ServerStream
+	if interceptor == nil {
+		return srv.(CryptoServiceServer).GetVoteProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crypto.CryptoService/GetVoteProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoServiceServer).GetVoteProof(ctx, req.(*GetVoteProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoService_GetVoteHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVoteHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoServiceServer).GetVoteHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crypto.CryptoService/GetVoteHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoServiceServer).GetVoteHistory(ctx, req.(*GetVoteHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoService_GetCryptoStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCryptoStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoServiceServer).GetCryptoStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crypto.CryptoService/GetCryptoStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoServiceServer).GetCryptoStats(ctx, req.(*GetCryptoStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoService_GetCatalogStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCatalogStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoServiceServer).GetCatalogStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crypto.CryptoService/GetCatalogStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoServiceServer).GetCatalogStats(ctx, req.(*GetCatalogStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoService_FilterByName_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FilterByNameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CryptoServiceServer).FilterByName(m, &cryptoServiceFilterByNameServer{stream})
+}
+
+type CryptoService_FilterByNameServer interface {
+	Send(*Crypto) error
+	grpc.ServerStream
+}
+
+type cryptoServiceFilterByNameServer struct {
+	grpc.ServerStream
 }
 
 func (x *cryptoServiceFilterByNameServer) Send(m *Crypto) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _CryptoService_GetCryptoBySymbol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCryptoBySymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoServiceServer).GetCryptoBySymbol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crypto.CryptoService/GetCryptoBySymbol",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoServiceServer).GetCryptoBySymbol(ctx, req.(*GetCryptoBySymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoService_GetCryptoBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCryptoBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoServiceServer).GetCryptoBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crypto.CryptoService/GetCryptoBySlug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoServiceServer).GetCryptoBySlug(ctx, req.(*GetCryptoBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoService_SearchCryptos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCryptosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoServiceServer).SearchCryptos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crypto.CryptoService/SearchCryptos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoServiceServer).SearchCryptos(ctx, req.(*SearchCryptosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoService_SuggestCryptos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestCryptosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoServiceServer).SuggestCryptos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crypto.CryptoService/SuggestCryptos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoServiceServer).SuggestCryptos(ctx, req.(*SuggestCryptosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoService_ListTrending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoServiceServer).ListTrending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crypto.CryptoService/ListTrending",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoServiceServer).ListTrending(ctx, req.(*ListTrendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoService_WatchCryptos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCryptosRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CryptoServiceServer).WatchCryptos(m, &cryptoServiceWatchCryptosServer{stream})
+}
+
+type CryptoService_WatchCryptosServer interface {
+	Send(*WatchCryptosResponse) error
+	grpc.ServerStream
+}
+
+type cryptoServiceWatchCryptosServer struct {
+	grpc.ServerStream
+}
+
+func (x *cryptoServiceWatchCryptosServer) Send(m *WatchCryptosResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CryptoService_BatchGetCryptos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetCryptosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoServiceServer).BatchGetCryptos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crypto.CryptoService/BatchGetCryptos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoServiceServer).BatchGetCryptos(ctx, req.(*BatchGetCryptosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoService_BatchCreateCryptos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateCryptosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoServiceServer).BatchCreateCryptos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crypto.CryptoService/BatchCreateCryptos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoServiceServer).BatchCreateCryptos(ctx, req.(*BatchCreateCryptosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoService_BatchVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoServiceServer).BatchVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crypto.CryptoService/BatchVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoServiceServer).BatchVote(ctx, req.(*BatchVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoService_CastSignedVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CastSignedVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoServiceServer).CastSignedVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crypto.CryptoService/CastSignedVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoServiceServer).CastSignedVote(ctx, req.(*CastSignedVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoService_GetVoteChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVoteChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoServiceServer).GetVoteChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crypto.CryptoService/GetVoteChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoServiceServer).GetVoteChallenge(ctx, req.(*GetVoteChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoService_ImportCryptos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CryptoServiceServer).ImportCryptos(&cryptoServiceImportCryptosServer{stream})
+}
+
+type CryptoService_ImportCryptosServer interface {
+	SendAndClose(*ImportCryptosResponse) error
+	Recv() (*ImportCryptoItem, error)
+	grpc.ServerStream
+}
+
+type cryptoServiceImportCryptosServer struct {
+	grpc.ServerStream
+}
+
+func (x *cryptoServiceImportCryptosServer) SendAndClose(m *ImportCryptosResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *cryptoServiceImportCryptosServer) Recv() (*ImportCryptoItem, error) {
+	m := new(ImportCryptoItem)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CryptoService_ExportCryptos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportCryptosRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CryptoServiceServer).ExportCryptos(m, &cryptoServiceExportCryptosServer{stream})
+}
+
+type CryptoService_ExportCryptosServer interface {
+	Send(*ExportCryptosResponse) error
+	grpc.ServerStream
+}
+
+type cryptoServiceExportCryptosServer struct {
+	grpc.ServerStream
+}
+
+func (x *cryptoServiceExportCryptosServer) Send(m *ExportCryptosResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CryptoService_RestoreCrypto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCryptoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoServiceServer).RestoreCrypto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crypto.CryptoService/RestoreCrypto",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoServiceServer).RestoreCrypto(ctx, req.(*RestoreCryptoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoService_ListDeletedCryptos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListDeletedCryptosRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CryptoServiceServer).ListDeletedCryptos(m, &cryptoServiceListDeletedCryptosServer{stream})
+}
+
+type CryptoService_ListDeletedCryptosServer interface {
+	Send(*ListDeletedCryptosResponse) error
+	grpc.ServerStream
+}
+
+type cryptoServiceListDeletedCryptosServer struct {
+	grpc.ServerStream
+}
+
+func (x *cryptoServiceListDeletedCryptosServer) Send(m *ListDeletedCryptosResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CryptoService_PurgeCrypto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeCryptoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoServiceServer).PurgeCrypto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crypto.CryptoService/PurgeCrypto",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoServiceServer).PurgeCrypto(ctx, req.(*PurgeCryptoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoService_GetCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCacheStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoServiceServer).GetCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crypto.CryptoService/GetCacheStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoServiceServer).GetCacheStats(ctx, req.(*GetCacheStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoService_ListFraudCases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFraudCasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoServiceServer).ListFraudCases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crypto.CryptoService/ListFraudCases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoServiceServer).ListFraudCases(ctx, req.(*ListFraudCasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoService_ReviewFraudCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewFraudCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoServiceServer).ReviewFraudCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crypto.CryptoService/ReviewFraudCase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoServiceServer).ReviewFraudCase(ctx, req.(*ReviewFraudCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CryptoService_ServiceDesc is the grpc.ServiceDesc for CryptoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -499,6 +1348,82 @@ var CryptoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CountVotes",
 			Handler:    _CryptoService_CountVotes_Handler,
 		},
+		{
+			MethodName: "GetVoteProof",
+			Handler:    _CryptoService_GetVoteProof_Handler,
+		},
+		{
+			MethodName: "GetVoteHistory",
+			Handler:    _CryptoService_GetVoteHistory_Handler,
+		},
+		{
+			MethodName: "GetCryptoStats",
+			Handler:    _CryptoService_GetCryptoStats_Handler,
+		},
+		{
+			MethodName: "GetCatalogStats",
+			Handler:    _CryptoService_GetCatalogStats_Handler,
+		},
+		{
+			MethodName: "GetCryptoBySymbol",
+			Handler:    _CryptoService_GetCryptoBySymbol_Handler,
+		},
+		{
+			MethodName: "GetCryptoBySlug",
+			Handler:    _CryptoService_GetCryptoBySlug_Handler,
+		},
+		{
+			MethodName: "SearchCryptos",
+			Handler:    _CryptoService_SearchCryptos_Handler,
+		},
+		{
+			MethodName: "SuggestCryptos",
+			Handler:    _CryptoService_SuggestCryptos_Handler,
+		},
+		{
+			MethodName: "ListTrending",
+			Handler:    _CryptoService_ListTrending_Handler,
+		},
+		{
+			MethodName: "BatchGetCryptos",
+			Handler:    _CryptoService_BatchGetCryptos_Handler,
+		},
+		{
+			MethodName: "BatchCreateCryptos",
+			Handler:    _CryptoService_BatchCreateCryptos_Handler,
+		},
+		{
+			MethodName: "BatchVote",
+			Handler:    _CryptoService_BatchVote_Handler,
+		},
+		{
+			MethodName: "CastSignedVote",
+			Handler:    _CryptoService_CastSignedVote_Handler,
+		},
+		{
+			MethodName: "GetVoteChallenge",
+			Handler:    _CryptoService_GetVoteChallenge_Handler,
+		},
+		{
+			MethodName: "RestoreCrypto",
+			Handler:    _CryptoService_RestoreCrypto_Handler,
+		},
+		{
+			MethodName: "PurgeCrypto",
+			Handler:    _CryptoService_PurgeCrypto_Handler,
+		},
+		{
+			MethodName: "GetCacheStats",
+			Handler:    _CryptoService_GetCacheStats_Handler,
+		},
+		{
+			MethodName: "ListFraudCases",
+			Handler:    _CryptoService_ListFraudCases_Handler,
+		},
+		{
+			MethodName: "ReviewFraudCase",
+			Handler:    _CryptoService_ReviewFraudCase_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -511,6 +1436,26 @@ var CryptoService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _CryptoService_FilterByName_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchCryptos",
+			Handler:       _CryptoService_WatchCryptos_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportCryptos",
+			Handler:       _CryptoService_ImportCryptos_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportCryptos",
+			Handler:       _CryptoService_ExportCryptos_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListDeletedCryptos",
+			Handler:       _CryptoService_ListDeletedCryptos_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "crypto.proto",
 }
@@ -0,0 +1,119 @@
+package apperrors
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/topology"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const Domain = "klever-challenge"
+
+// Reason codes sent in ErrorInfo so clients can branch without parsing messages.
+const (
+	ReasonNotFound          = "RESOURCE_NOT_FOUND"
+	ReasonAlreadyExists     = "RESOURCE_ALREADY_EXISTS"
+	ReasonInvalidArgument   = "INVALID_ARGUMENT"
+	ReasonTimeout           = "DATABASE_TIMEOUT"
+	ReasonCancelled         = "REQUEST_CANCELLED"
+	ReasonUnavailable       = "DATABASE_UNAVAILABLE"
+	ReasonInternal          = "INTERNAL"
+	ReasonUnauthenticated   = "UNAUTHENTICATED"
+	ReasonPermissionDenied  = "PERMISSION_DENIED"
+	ReasonKeyReused         = "IDEMPOTENCY_KEY_REUSED"
+	ReasonInProgress        = "REQUEST_IN_PROGRESS"
+	ReasonNonceReused       = "NONCE_REUSED"
+	ReasonBadSignature      = "INVALID_SIGNATURE"
+	ReasonChallengeRequired = "VOTE_CHALLENGE_REQUIRED"
+	ReasonChallengeFailed   = "VOTE_CHALLENGE_FAILED"
+	ReasonNoTransactions    = "TRANSACTIONS_UNSUPPORTED"
+	ReasonInTrash           = "RESOURCE_IN_TRASH"
+)
+
+const retryDelay = time.Second
+
+func New(code codes.Code, reason string, message string, details ...protoiface.MessageV1) error {
+	st := status.New(code, message)
+
+	details = append([]protoiface.MessageV1{&errdetails.ErrorInfo{Reason: reason, Domain: Domain}}, details...)
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+func NotFound(resourceType, name string) error {
+	return New(codes.NotFound, ReasonNotFound, "Could not find "+resourceType+" "+name,
+		&errdetails.ResourceInfo{ResourceType: resourceType, ResourceName: name, Description: "resource not found"},
+	)
+}
+
+func AlreadyExists(resourceType, field, value string) error {
+	return New(codes.AlreadyExists, ReasonAlreadyExists, "A "+resourceType+" with "+field+" "+value+" already exists",
+		&errdetails.ResourceInfo{ResourceType: resourceType, ResourceName: value, Description: field + " must be unique"},
+	)
+}
+
+// InTrash reports a unique value held by a soft deleted resource, which
+// must be restored or purged before the value is free again.
+func InTrash(resourceType, field, value, id string) error {
+	return New(codes.FailedPrecondition, ReasonInTrash, "A deleted "+resourceType+" with "+field+" "+value+" is in the trash; restore or purge "+id+" first",
+		&errdetails.ResourceInfo{ResourceType: resourceType, ResourceName: id, Description: field + " " + value + " is held by a deleted " + resourceType},
+	)
+}
+
+func InvalidArgument(field, description string) error {
+	return New(codes.InvalidArgument, ReasonInvalidArgument, field+" "+description,
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: field + " " + description},
+		}},
+	)
+}
+
+// FromDB maps an error returned by the MongoDB driver (or the request context)
+// to a gRPC status. The raw driver error is only logged, never sent to clients.
+func FromDB(err error, resourceType, name string) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return NotFound(resourceType, name)
+	case mongo.IsDuplicateKeyError(err):
+		return New(codes.AlreadyExists, ReasonAlreadyExists, "The "+resourceType+" conflicts with an existing one",
+			&errdetails.ResourceInfo{ResourceType: resourceType, ResourceName: name, Description: "duplicate key"},
+		)
+	case errors.Is(err, context.Canceled):
+		return New(codes.Canceled, ReasonCancelled, "Request was cancelled")
+	case errors.Is(err, context.DeadlineExceeded) || mongo.IsTimeout(err):
+		return New(codes.DeadlineExceeded, ReasonTimeout, "Database did not answer in time",
+			&errdetails.RetryInfo{RetryDelay: durationpb.New(retryDelay)},
+		)
+	case mongo.IsNetworkError(err) || errors.Is(err, mongo.ErrClientDisconnected) || isServerSelection(err):
+		return New(codes.Unavailable, ReasonUnavailable, "Database is unavailable",
+			&errdetails.RetryInfo{RetryDelay: durationpb.New(retryDelay)},
+		)
+	}
+
+	log.Printf("Unexpected database error on %s %s: %v", resourceType, name, err)
+	return New(codes.Internal, ReasonInternal, "Internal error")
+}
+
+func isServerSelection(err error) bool {
+	var selection topology.ServerSelectionError
+	return errors.As(err, &selection)
+}
@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"api/app/pb"
+	"api/apperrors"
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// adminOnly reports whether the method behind a "/package.Service/Method"
+// name is marked with the (admin_only) option in the proto files.
+func adminOnly(fullMethod string) bool {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", "."))
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return false
+	}
+
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return false
+	}
+
+	adminOnly, _ := proto.GetExtension(method.Options(), pb.E_AdminOnly).(bool)
+	return adminOnly
+}
+
+func bearer(ctx context.Context) (string, bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", false
+	}
+
+	return strings.TrimPrefix(values[0], "Bearer "), true
+}
+
+// Authenticated reports whether the request carries the admin token.
+func Authenticated(ctx context.Context, adminToken string) bool {
+	token, ok := bearer(ctx)
+	return ok && adminToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) == 1
+}
+
+func authorize(ctx context.Context, fullMethod, adminToken string) error {
+	if !adminOnly(fullMethod) {
+		return nil
+	}
+
+	if adminToken == "" {
+		return apperrors.New(codes.PermissionDenied, apperrors.ReasonPermissionDenied, "Admin methods are disabled")
+	}
+
+	token, ok := bearer(ctx)
+	if !ok {
+		return apperrors.New(codes.Unauthenticated, apperrors.ReasonUnauthenticated, "Missing authorization metadata")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) != 1 {
+		return apperrors.New(codes.PermissionDenied, apperrors.ReasonPermissionDenied, "Invalid admin token")
+	}
+
+	return nil
+}
+
+func UnaryServerInterceptor(adminToken string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := authorize(ctx, info.FullMethod, adminToken); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func StreamServerInterceptor(adminToken string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(stream.Context(), info.FullMethod, adminToken); err != nil {
+			return err
+		}
+
+		return handler(srv, stream)
+	}
+}
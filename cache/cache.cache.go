@@ -0,0 +1,268 @@
+package cache
+
+import (
+	"api/events"
+	"api/ranking"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// Store holds cached values. Values expire after their ttl.
+type Store interface {
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+	Clear(ctx context.Context) error
+}
+
+type Stats struct {
+	Hits   int64
+	Misses int64
+	// SharedLoads counts misses served by a load another caller started.
+	SharedLoads   int64
+	Errors        int64
+	Invalidations int64
+	Evictions     int64
+	Entries       int64
+}
+
+// loadTimeout bounds a shared load, which no longer follows the deadline of
+// the caller that started it.
+const loadTimeout = 10 * time.Second
+
+// Cache reads through Store. Concurrent misses on a key share one load, and
+// a load that raced with an invalidation is returned but not stored. Store
+// errors are logged and the value is loaded as if it were not cached.
+type Cache struct {
+	Store Store
+	TTL   time.Duration
+	// ListDelay coalesces the list invalidations caused by votes: the lists
+	// are dropped at most once per ListDelay while votes come in, instead of
+	// on every vote. Other changes still drop them right away.
+	ListDelay time.Duration
+
+	// versions counts the invalidations of each crypto key and of the list
+	// keys as a group, so a load only loses to an invalidation of what it
+	// loads; generation counts the clears, which invalidate every key.
+	mu         sync.Mutex
+	versions   map[string]int64
+	generation int64
+	flights    singleflight.Group
+	listsStale int32
+
+	hits, misses, loads, errors, invalidations int64
+}
+
+func New(store Store, ttl time.Duration) *Cache {
+	return &Cache{Store: store, TTL: ttl}
+}
+
+func CryptoKey(id string) string {
+	return "crypto:" + id
+}
+
+func ListKey(field string) string {
+	return "list:" + field
+}
+
+// version identifies the state of a key a load started from.
+type version struct {
+	generation, count int64
+}
+
+// versionGroup is the key whose invalidations key follows: a list is
+// dropped along with every other list.
+func versionGroup(key string) string {
+	if strings.HasPrefix(key, "list:") {
+		return ListKey("")
+	}
+	return key
+}
+
+func (c *Cache) version(key string) version {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return version{c.generation, c.versions[versionGroup(key)]}
+}
+
+// bumpAll invalidates loads of every key.
+func (c *Cache) bumpAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	c.versions = nil
+}
+
+// bump invalidates loads of keys.
+func (c *Cache) bump(keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.versions == nil {
+		c.versions = make(map[string]int64)
+	}
+	for _, key := range keys {
+		c.versions[versionGroup(key)]++
+	}
+}
+
+// listKeys are the keys of every cached ListCryptos ordering.
+var listKeys = []string{
+	ListKey(ranking.NetField),
+	ListKey(ranking.RatioField),
+	ListKey(ranking.WilsonField),
+	ListKey(ranking.BayesianField),
+	ListKey(ranking.HotField),
+	ListKey(ranking.ControversyField),
+}
+
+// Fetch returns the value cached under key, or loads and caches it. A nil
+// Cache always loads.
+func (c *Cache) Fetch(ctx context.Context, key string, load func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	if c == nil {
+		return load(ctx)
+	}
+
+	value, ok, err := c.Store.Get(ctx, key)
+	if err != nil {
+		atomic.AddInt64(&c.errors, 1)
+		log.Printf("Could not read %s from the cache: %v", key, err)
+	} else if ok {
+		atomic.AddInt64(&c.hits, 1)
+		return value, nil
+	}
+	atomic.AddInt64(&c.misses, 1)
+
+	// callers arriving after an invalidation must not join an older load
+	started := c.version(key)
+	flight := fmt.Sprintf("%d.%d/%s", started.generation, started.count, key)
+	results := c.flights.DoChan(flight, func() (interface{}, error) {
+		// the load is shared, so the caller that started it giving up must
+		// not fail the others
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), loadTimeout)
+		defer cancel()
+
+		atomic.AddInt64(&c.loads, 1)
+		value, err := load(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if c.version(key) == started {
+			if err := c.Store.Set(ctx, key, value, c.TTL); err != nil {
+				atomic.AddInt64(&c.errors, 1)
+				log.Printf("Could not write %s to the cache: %v", key, err)
+			}
+		}
+		return value, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-results:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		return result.Val.([]byte), nil
+	}
+}
+
+// Invalidate drops what the events make stale: the changed cryptos and every
+// list they may appear in, or everything after an import. Lists only changed
+// by votes are dropped after ListDelay.
+func (c *Cache) Invalidate(ctx context.Context, changes ...events.Event) {
+	if c == nil || len(changes) == 0 {
+		return
+	}
+
+	atomic.AddInt64(&c.invalidations, 1)
+
+	var keys []string
+	votesOnly := c.ListDelay > 0
+	for _, event := range changes {
+		if event.Type != events.Voted {
+			votesOnly = false
+		}
+	}
+	if votesOnly {
+		c.invalidateListsLater()
+	} else {
+		keys = append(keys, listKeys...)
+	}
+
+	for _, event := range changes {
+		if event.Type == events.Imported {
+			c.bumpAll()
+			if err := c.Store.Clear(ctx); err != nil {
+				atomic.AddInt64(&c.errors, 1)
+				log.Printf("Could not clear the cache: %v", err)
+			}
+			return
+		}
+		if event.Type != events.Created {
+			keys = append(keys, CryptoKey(event.CryptoId))
+		}
+	}
+
+	c.bump(keys...)
+	if err := c.Store.Delete(ctx, keys...); err != nil {
+		atomic.AddInt64(&c.errors, 1)
+		log.Printf("Could not invalidate the cache: %v", err)
+	}
+}
+
+// invalidateListsLater drops the lists once ListDelay has passed, unless a
+// drop is already scheduled.
+func (c *Cache) invalidateListsLater() {
+	if !atomic.CompareAndSwapInt32(&c.listsStale, 0, 1) {
+		return
+	}
+
+	time.AfterFunc(c.ListDelay, func() {
+		atomic.StoreInt32(&c.listsStale, 0)
+		c.bump(listKeys...)
+
+		ctx, cancel := context.WithTimeout(context.Background(), loadTimeout)
+		defer cancel()
+		if err := c.Store.Delete(ctx, listKeys...); err != nil {
+			atomic.AddInt64(&c.errors, 1)
+			log.Printf("Could not invalidate the cached lists: %v", err)
+		}
+	})
+}
+
+// Watch invalidates the cache as changes are published, including those
+// made on other instances when the bus is fed by the change stream.
+func (c *Cache) Watch(ctx context.Context, changes <-chan events.Event) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-changes:
+			c.Invalidate(ctx, event)
+		}
+	}
+}
+
+func (c *Cache) Stats() Stats {
+	loads := atomic.LoadInt64(&c.loads)
+	stats := Stats{
+		Hits:          atomic.LoadInt64(&c.hits),
+		Misses:        atomic.LoadInt64(&c.misses),
+		SharedLoads:   atomic.LoadInt64(&c.misses) - loads,
+		Errors:        atomic.LoadInt64(&c.errors),
+		Invalidations: atomic.LoadInt64(&c.invalidations),
+	}
+	if lru, ok := c.Store.(*LRU); ok {
+		stats.Evictions, stats.Entries = lru.Stats()
+	}
+
+	return stats
+}
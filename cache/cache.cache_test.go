@@ -0,0 +1,148 @@
+package cache
+
+import (
+	"api/events"
+	"api/ranking"
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// countingStore counts the deletions of each key.
+type countingStore struct {
+	*LRU
+	mu      sync.Mutex
+	deletes map[string]int
+}
+
+func (s *countingStore) Delete(ctx context.Context, keys ...string) error {
+	s.mu.Lock()
+	for _, key := range keys {
+		s.deletes[key]++
+	}
+	s.mu.Unlock()
+
+	return s.LRU.Delete(ctx, keys...)
+}
+
+func (s *countingStore) count(key string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.deletes[key]
+}
+
+func TestSharedLoadOutlivesCaller(t *testing.T) {
+	c := New(NewLRU(10), time.Minute)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	load := func(ctx context.Context) ([]byte, error) {
+		close(started)
+		select {
+		case <-release:
+			return []byte("value"), nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	first, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		_, err := c.Fetch(first, CryptoKey("a"), load)
+		done <- err
+	}()
+	<-started
+
+	shared := make(chan []byte)
+	go func() {
+		value, err := c.Fetch(context.Background(), CryptoKey("a"), func(ctx context.Context) ([]byte, error) {
+			t.Error("the second caller started its own load")
+			return nil, nil
+		})
+		if err != nil {
+			t.Error(err)
+		}
+		shared <- value
+	}()
+
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Fatalf("cancelled caller got %v, want context.Canceled", err)
+	}
+
+	close(release)
+	if value := <-shared; string(value) != "value" {
+		t.Fatalf("second caller got %q, want %q", value, "value")
+	}
+}
+
+func TestVotesCoalesceListInvalidation(t *testing.T) {
+	store := &countingStore{LRU: NewLRU(10), deletes: map[string]int{}}
+	c := New(store, time.Minute)
+	c.ListDelay = 50 * time.Millisecond
+
+	for i := 0; i < 100; i++ {
+		c.Invalidate(context.Background(), events.Event{Type: events.Voted, CryptoId: "a"})
+	}
+	if got := store.count(CryptoKey("a")); got != 100 {
+		t.Fatalf("crypto dropped %d times, want 100", got)
+	}
+	if got := store.count(listKeys[0]); got != 0 {
+		t.Fatalf("lists dropped %d times before the delay, want 0", got)
+	}
+
+	time.Sleep(200 * time.Millisecond)
+	if got := store.count(listKeys[0]); got != 1 {
+		t.Fatalf("lists dropped %d times after the delay, want 1", got)
+	}
+
+	c.Invalidate(context.Background(), events.Event{Type: events.Updated, CryptoId: "a"})
+	if got := store.count(listKeys[0]); got != 2 {
+		t.Fatalf("an update dropped the lists %d times in all, want 2", got)
+	}
+}
+
+// raceLoad fetches key with a load during which invalidate runs, and
+// reports whether the loaded value was stored.
+func raceLoad(t *testing.T, c *Cache, key string, invalidate func()) bool {
+	_, err := c.Fetch(context.Background(), key, func(ctx context.Context) ([]byte, error) {
+		invalidate()
+		return []byte("value"), nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, stored, err := c.Store.Get(context.Background(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stored
+}
+
+func TestInvalidationOnlyDiscardsItsOwnKeys(t *testing.T) {
+	c := New(NewLRU(10), time.Minute)
+	voted := func(id string) func() {
+		return func() { c.Invalidate(context.Background(), events.Event{Type: events.Voted, CryptoId: id}) }
+	}
+
+	if !raceLoad(t, c, CryptoKey("a"), voted("b")) {
+		t.Fatal("a vote on another crypto discarded the load")
+	}
+	if raceLoad(t, c, CryptoKey("c"), voted("c")) {
+		t.Fatal("a vote on the crypto kept the load")
+	}
+	if raceLoad(t, c, ListKey(ranking.HotField), voted("d")) {
+		t.Fatal("a vote kept the load of a list")
+	}
+
+	c.ListDelay = time.Hour
+	if !raceLoad(t, c, ListKey(ranking.WilsonField), voted("e")) {
+		t.Fatal("a vote dropping the lists later discarded the load")
+	}
+	if raceLoad(t, c, CryptoKey("f"), func() { c.Invalidate(context.Background(), events.Event{Type: events.Imported}) }) {
+		t.Fatal("an import kept the load")
+	}
+}
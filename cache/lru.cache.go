@@ -0,0 +1,108 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+type lruEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// LRU is an in-process Store holding at most Capacity values, evicting the
+// least recently used one first.
+type LRU struct {
+	Capacity int
+
+	mu        sync.Mutex
+	items     map[string]*list.Element
+	order     *list.List
+	evictions int64
+}
+
+func NewLRU(capacity int) *LRU {
+	return &LRU{
+		Capacity: capacity,
+		items:    map[string]*list.Element{},
+		order:    list.New(),
+	}
+}
+
+func (l *LRU) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	element, ok := l.items[key]
+	if !ok {
+		return nil, false, nil
+	}
+
+	entry := element.Value.(*lruEntry)
+	if time.Now().After(entry.expires) {
+		l.remove(element)
+		return nil, false, nil
+	}
+
+	l.order.MoveToFront(element)
+	return entry.value, true, nil
+}
+
+func (l *LRU) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	expires := time.Now().Add(ttl)
+	if element, ok := l.items[key]; ok {
+		entry := element.Value.(*lruEntry)
+		entry.value, entry.expires = value, expires
+		l.order.MoveToFront(element)
+		return nil
+	}
+
+	l.items[key] = l.order.PushFront(&lruEntry{key: key, value: value, expires: expires})
+	for l.Capacity > 0 && l.order.Len() > l.Capacity {
+		l.remove(l.order.Back())
+		l.evictions++
+	}
+
+	return nil
+}
+
+func (l *LRU) Delete(ctx context.Context, keys ...string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, key := range keys {
+		if element, ok := l.items[key]; ok {
+			l.remove(element)
+		}
+	}
+
+	return nil
+}
+
+func (l *LRU) Clear(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.items = map[string]*list.Element{}
+	l.order.Init()
+	return nil
+}
+
+// Stats reports how many values were evicted for space and how many are held.
+func (l *LRU) Stats() (evictions, entries int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.evictions, int64(l.order.Len())
+}
+
+func (l *LRU) remove(element *list.Element) {
+	l.order.Remove(element)
+	delete(l.items, element.Value.(*lruEntry).key)
+}
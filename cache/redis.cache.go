@@ -0,0 +1,238 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+type redisConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+// Redis is a Store shared by every instance, speaking RESP to any
+// Redis-compatible server. Keys are namespaced by Prefix so Clear only
+// removes this cache's entries.
+type Redis struct {
+	Addr     string
+	Password string
+	Prefix   string
+	Timeout  time.Duration
+
+	idle chan *redisConn
+}
+
+func NewRedis(addr, password, prefix string, timeout time.Duration, poolSize int) *Redis {
+	return &Redis{
+		Addr:     addr,
+		Password: password,
+		Prefix:   prefix,
+		Timeout:  timeout,
+		idle:     make(chan *redisConn, poolSize),
+	}
+}
+
+func (r *Redis) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	reply, err := r.do(ctx, "GET", r.Prefix+key)
+	if err != nil || reply == nil {
+		return nil, false, err
+	}
+
+	value, ok := reply.([]byte)
+	if !ok {
+		return nil, false, fmt.Errorf("redis: unexpected GET reply %v", reply)
+	}
+	return value, true, nil
+}
+
+func (r *Redis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	_, err := r.do(ctx, "SET", r.Prefix+key, string(value), "PX", strconv.FormatInt(ttl.Milliseconds(), 10))
+	return err
+}
+
+func (r *Redis) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	args := make([]string, 0, len(keys)+1)
+	args = append(args, "DEL")
+	for _, key := range keys {
+		args = append(args, r.Prefix+key)
+	}
+
+	_, err := r.do(ctx, args...)
+	return err
+}
+
+// Clear scans for the prefixed keys and deletes them batch by batch.
+func (r *Redis) Clear(ctx context.Context) error {
+	cursor := "0"
+	for {
+		reply, err := r.do(ctx, "SCAN", cursor, "MATCH", r.Prefix+"*", "COUNT", "500")
+		if err != nil {
+			return err
+		}
+
+		page, ok := reply.([]interface{})
+		if !ok || len(page) != 2 {
+			return fmt.Errorf("redis: unexpected SCAN reply %v", reply)
+		}
+		next, _ := page[0].([]byte)
+		found, _ := page[1].([]interface{})
+
+		if len(found) > 0 {
+			args := make([]string, 0, len(found)+1)
+			args = append(args, "DEL")
+			for _, key := range found {
+				if key, ok := key.([]byte); ok {
+					args = append(args, string(key))
+				}
+			}
+			if _, err := r.do(ctx, args...); err != nil {
+				return err
+			}
+		}
+
+		if cursor = string(next); cursor == "0" || cursor == "" {
+			return nil
+		}
+	}
+}
+
+// do sends one command on a pooled connection and reads its reply. A
+// connection that failed is dropped rather than returned to the pool.
+func (r *Redis) do(ctx context.Context, args ...string) (interface{}, error) {
+	c, err := r.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(r.Timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	c.conn.SetDeadline(deadline)
+
+	reply, err := command(c, args...)
+	var replyErr redisError
+	if err != nil && !errors.As(err, &replyErr) {
+		c.conn.Close()
+		return nil, err
+	}
+
+	select {
+	case r.idle <- c:
+	default:
+		c.conn.Close()
+	}
+
+	return reply, err
+}
+
+func (r *Redis) get(ctx context.Context) (*redisConn, error) {
+	select {
+	case c := <-r.idle:
+		return c, nil
+	default:
+	}
+
+	dialer := net.Dialer{Timeout: r.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", r.Addr)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &redisConn{conn: conn, r: bufio.NewReader(conn)}
+	if r.Password != "" {
+		conn.SetDeadline(time.Now().Add(r.Timeout))
+		if _, err := command(c, "AUTH", r.Password); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+func (r *Redis) Close() error {
+	for {
+		select {
+		case c := <-r.idle:
+			c.conn.Close()
+		default:
+			return nil
+		}
+	}
+}
+
+// redisError is an error reply; the connection stays usable after one.
+type redisError string
+
+func (e redisError) Error() string {
+	return "redis: " + string(e)
+}
+
+func command(c *redisConn, args ...string) (interface{}, error) {
+	request := fmt.Sprintf("*%d\r\n", len(args))
+	for _, arg := range args {
+		request += fmt.Sprintf("$%d\r\n%s\r\n", len(arg), arg)
+	}
+	if _, err := io.WriteString(c.conn, request); err != nil {
+		return nil, err
+	}
+
+	return readReply(c.r)
+}
+
+// readReply parses one RESP reply: nil for a null, []byte for strings,
+// int64 for integers and []interface{} for arrays.
+func readReply(r *bufio.Reader) (interface{}, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 {
+		return nil, fmt.Errorf("redis: malformed reply %q", line)
+	}
+
+	kind, body := line[0], line[1:len(line)-2]
+	switch kind {
+	case '+':
+		return []byte(body), nil
+	case '-':
+		return nil, redisError(body)
+	case ':':
+		return strconv.ParseInt(body, 10, 64)
+	case '$':
+		size, err := strconv.Atoi(body)
+		if err != nil || size < 0 {
+			return nil, err
+		}
+		value := make([]byte, size+2)
+		if _, err := io.ReadFull(r, value); err != nil {
+			return nil, err
+		}
+		return value[:size], nil
+	case '*':
+		size, err := strconv.Atoi(body)
+		if err != nil || size < 0 {
+			return nil, err
+		}
+		items := make([]interface{}, size)
+		for i := range items {
+			if items[i], err = readReply(r); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	}
+
+	return nil, fmt.Errorf("redis: malformed reply %q", line)
+}
@@ -0,0 +1,41 @@
+package catalog
+
+import (
+	"api/models"
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Export writes every crypto, ordered by id, and returns how many were written.
+// The writer is not closed so callers can tell a failed export from a complete one.
+func Export(ctx context.Context, db *mongo.Collection, w Writer, includeDeleted bool) (int64, error) {
+	filter := bson.M{"deletedAt": nil}
+	if includeDeleted {
+		filter = bson.M{}
+	}
+
+	cursor, err := db.Find(ctx, filter, options.Find().SetSort(bson.M{"_id": 1}))
+	if err != nil {
+		return 0, err
+	}
+
+	defer cursor.Close(context.Background())
+
+	var count int64
+	for cursor.Next(ctx) {
+		var item models.CryptoItem
+		if err := cursor.Decode(&item); err != nil {
+			return count, err
+		}
+
+		if err := w.Write(item); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	return count, cursor.Err()
+}
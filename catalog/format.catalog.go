@@ -0,0 +1,224 @@
+package catalog
+
+import (
+	"api/models"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	FormatCSV    = "csv"
+	FormatJSON   = "json"
+	FormatNDJSON = "ndjson"
+)
+
+var header = []string{"id", "name", "symbol", "slug", "description", "likes", "dislikes", "voteRate", "createdAt", "updatedAt", "deletedAt"}
+
+type Writer interface {
+	Write(item models.CryptoItem) error
+	Close() error
+}
+
+type Reader interface {
+	// Read returns io.EOF once every record has been read.
+	Read() (models.CryptoItem, error)
+}
+
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		return &csvWriter{w: cw}, cw.Write(header)
+	case FormatJSON:
+		return &jsonWriter{w: w, first: true}, nil
+	case FormatNDJSON:
+		return &ndjsonWriter{enc: json.NewEncoder(w)}, nil
+	}
+
+	return nil, fmt.Errorf("unknown format %q", format)
+}
+
+func NewReader(format string, r io.Reader) (Reader, error) {
+	switch format {
+	case FormatCSV:
+		cr := csv.NewReader(r)
+		cr.FieldsPerRecord = len(header)
+		columns, err := cr.Read()
+		if err != nil {
+			return nil, err
+		}
+		for i, column := range columns {
+			if column != header[i] {
+				return nil, fmt.Errorf("unexpected csv column %q, expected %q", column, header[i])
+			}
+		}
+		return &csvReader{r: cr}, nil
+	case FormatJSON:
+		dec := json.NewDecoder(r)
+		if token, err := dec.Token(); err != nil || token != json.Delim('[') {
+			return nil, fmt.Errorf("json export must be an array")
+		}
+		return &jsonReader{dec: dec}, nil
+	case FormatNDJSON:
+		return &ndjsonReader{dec: json.NewDecoder(r)}, nil
+	}
+
+	return nil, fmt.Errorf("unknown format %q", format)
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func (c *csvWriter) Write(item models.CryptoItem) error {
+	deletedAt := ""
+	if item.DeletedAt != nil {
+		deletedAt = formatTime(*item.DeletedAt)
+	}
+
+	return c.w.Write([]string{
+		item.Id.Hex(),
+		item.Name,
+		item.Symbol,
+		item.Slug,
+		item.Description,
+		strconv.FormatInt(item.Likes, 10),
+		strconv.FormatInt(item.Dislikes, 10),
+		strconv.FormatInt(item.VoteRate, 10),
+		formatTime(item.CreatedAt),
+		formatTime(item.UpdatedAt),
+		deletedAt,
+	})
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type csvReader struct {
+	r *csv.Reader
+}
+
+func (c *csvReader) Read() (models.CryptoItem, error) {
+	var item models.CryptoItem
+
+	record, err := c.r.Read()
+	if err != nil {
+		return item, err
+	}
+
+	line, _ := c.r.FieldPos(0)
+	fail := func(column string, err error) (models.CryptoItem, error) {
+		return item, fmt.Errorf("line %d, column %s: %w", line, column, err)
+	}
+
+	if item.Id, err = primitive.ObjectIDFromHex(record[0]); err != nil {
+		return fail("id", err)
+	}
+	item.Name = record[1]
+	item.Symbol = record[2]
+	item.Slug = record[3]
+	item.Description = record[4]
+	if item.Likes, err = strconv.ParseInt(record[5], 10, 64); err != nil {
+		return fail("likes", err)
+	}
+	if item.Dislikes, err = strconv.ParseInt(record[6], 10, 64); err != nil {
+		return fail("dislikes", err)
+	}
+	if item.VoteRate, err = strconv.ParseInt(record[7], 10, 64); err != nil {
+		return fail("voteRate", err)
+	}
+	if item.CreatedAt, err = time.Parse(time.RFC3339Nano, record[8]); err != nil {
+		return fail("createdAt", err)
+	}
+	if item.UpdatedAt, err = time.Parse(time.RFC3339Nano, record[9]); err != nil {
+		return fail("updatedAt", err)
+	}
+	if record[10] != "" {
+		deletedAt, err := time.Parse(time.RFC3339Nano, record[10])
+		if err != nil {
+			return fail("deletedAt", err)
+		}
+		item.DeletedAt = &deletedAt
+	}
+
+	return item, nil
+}
+
+type jsonWriter struct {
+	w     io.Writer
+	first bool
+}
+
+func (j *jsonWriter) Write(item models.CryptoItem) error {
+	prefix := ",\n  "
+	if j.first {
+		prefix = "[\n  "
+		j.first = false
+	}
+
+	data, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(j.w, "%s%s", prefix, data)
+	return err
+}
+
+func (j *jsonWriter) Close() error {
+	suffix := "\n]\n"
+	if j.first {
+		suffix = "[]\n"
+	}
+
+	_, err := io.WriteString(j.w, suffix)
+	return err
+}
+
+type jsonReader struct {
+	dec *json.Decoder
+}
+
+func (j *jsonReader) Read() (models.CryptoItem, error) {
+	var item models.CryptoItem
+	if !j.dec.More() {
+		return item, io.EOF
+	}
+
+	err := j.dec.Decode(&item)
+	return item, err
+}
+
+type ndjsonWriter struct {
+	enc *json.Encoder
+}
+
+func (n *ndjsonWriter) Write(item models.CryptoItem) error {
+	return n.enc.Encode(item)
+}
+
+func (n *ndjsonWriter) Close() error {
+	return nil
+}
+
+type ndjsonReader struct {
+	dec *json.Decoder
+}
+
+func (n *ndjsonReader) Read() (models.CryptoItem, error) {
+	var item models.CryptoItem
+	err := n.dec.Decode(&item)
+	return item, err
+}
@@ -0,0 +1,135 @@
+package catalog
+
+import (
+	"api/models"
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func fixture() []models.CryptoItem {
+	created := time.Date(2024, 2, 29, 23, 59, 59, 123456789, time.UTC)
+	deleted := time.Date(2024, 3, 1, 8, 0, 0, 1, time.UTC)
+
+	return []models.CryptoItem{
+		{
+			Id:          primitive.ObjectID{0x65, 0xf0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+			Name:        "BITCOIN",
+			Symbol:      "BTC",
+			Slug:        "bitcoin",
+			Description: "Peer-to-peer electronic cash",
+			Likes:       1024,
+			Dislikes:    12,
+			VoteRate:    1012,
+			CreatedAt:   created,
+			UpdatedAt:   created.Add(time.Hour),
+		},
+		{
+			Id:          primitive.ObjectID{0x65, 0xf0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2},
+			Name:        "ÉTHER, \"CLASSIC\"",
+			Symbol:      "ETC",
+			Slug:        "ether-classic",
+			Description: "Commas, \"quotes\",\nnew lines and unicode: 以太坊 🚀",
+			Likes:       0,
+			Dislikes:    7,
+			VoteRate:    -7,
+			CreatedAt:   created,
+			UpdatedAt:   deleted,
+			DeletedAt:   &deleted,
+		},
+		{
+			Id:        primitive.ObjectID{0x65, 0xf0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3},
+			Name:      "EMPTY",
+			Symbol:    "E",
+			Slug:      "empty",
+			CreatedAt: created,
+			UpdatedAt: created,
+		},
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, format := range []string{FormatCSV, FormatJSON, FormatNDJSON} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := NewWriter(format, &buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			items := fixture()
+			for _, item := range items {
+				if err := w.Write(item); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			r, err := NewReader(format, &buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var read []models.CryptoItem
+			for {
+				item, err := r.Read()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				read = append(read, item)
+			}
+
+			if !reflect.DeepEqual(read, items) {
+				t.Fatalf("read back\n%+v\nwant\n%+v", read, items)
+			}
+		})
+	}
+}
+
+func TestRoundTripEmpty(t *testing.T) {
+	for _, format := range []string{FormatCSV, FormatJSON, FormatNDJSON} {
+		var buf bytes.Buffer
+		w, err := NewWriter(format, &buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		r, err := NewReader(format, &buf)
+		if err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+		if _, err := r.Read(); err != io.EOF {
+			t.Fatalf("%s: got %v, want io.EOF", format, err)
+		}
+	}
+}
+
+func TestReadCSVErrors(t *testing.T) {
+	row := "65f000000000000000000001,BITCOIN,BTC,bitcoin,,1,2,-1,2024-01-01T00:00:00Z,2024-01-01T00:00:00Z,"
+	tests := map[string]string{
+		"wrong header": "id,name\n",
+		"bad id":       strings.Join(header, ",") + "\n" + strings.Replace(row, "65f0", "zz", 1) + "\n",
+		"bad likes":    strings.Join(header, ",") + "\n" + strings.Replace(row, ",1,2,", ",one,2,", 1) + "\n",
+		"bad time":     strings.Join(header, ",") + "\n" + strings.Replace(row, "2024-01-01T00:00:00Z,", "yesterday,", 1) + "\n",
+	}
+	for name, input := range tests {
+		r, err := NewReader(FormatCSV, strings.NewReader(input))
+		if err == nil {
+			_, err = r.Read()
+		}
+		if err == nil || err == io.EOF {
+			t.Errorf("%s: got %v, want an error", name, err)
+		}
+	}
+}
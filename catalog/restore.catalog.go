@@ -0,0 +1,156 @@
+package catalog
+
+import (
+	"api/app/pb"
+	"api/models"
+	"api/ranking"
+	"api/validator"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type RowError struct {
+	Row    int64
+	Symbol string
+	Err    error
+}
+
+type Summary struct {
+	Restored int64
+	Failed   int64
+	Errors   []RowError
+}
+
+// Validate applies the CreateCrypto field rules to an exported record and
+// checks the fields only present in exports.
+func Validate(item models.CryptoItem) error {
+	var problems []string
+	violations := validator.Validate(&pb.CreateCryptoRequest{
+		Name:        item.Name,
+		Description: item.Description,
+		Symbol:      item.Symbol,
+		Slug:        item.Slug,
+	})
+	for _, v := range violations {
+		problems = append(problems, v.GetDescription())
+	}
+
+	if item.Id.IsZero() {
+		problems = append(problems, "id is required")
+	}
+	if item.Slug == "" {
+		problems = append(problems, "slug is required")
+	}
+	if item.Likes < 0 || item.Dislikes < 0 {
+		problems = append(problems, "likes and dislikes must not be negative")
+	}
+	if item.CreatedAt.IsZero() {
+		problems = append(problems, "createdAt is required")
+	}
+
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+// restoreUpdate sets the exported fields of a record. The fields exports
+// leave out, such as the vote flush markers of running instances, are kept.
+func restoreUpdate(item models.CryptoItem) bson.M {
+	update := bson.M{"$set": bson.M{
+		"name":        item.Name,
+		"symbol":      item.Symbol,
+		"slug":        item.Slug,
+		"description": item.Description,
+		"likes":       item.Likes,
+		"dislikes":    item.Dislikes,
+		"voteRate":    item.VoteRate,
+		"scores":      item.Scores,
+		"createdAt":   item.CreatedAt,
+		"updatedAt":   item.UpdatedAt,
+	}}
+	if item.DeletedAt != nil {
+		update["$set"].(bson.M)["deletedAt"] = item.DeletedAt
+	} else {
+		update["$unset"] = bson.M{"deletedAt": ""}
+	}
+
+	return update
+}
+
+// Restore upserts every valid record by id, keeping its votes and timestamps.
+// Invalid or conflicting records are reported per row; a malformed file stops the restore.
+func Restore(ctx context.Context, db *mongo.Collection, r Reader, batchSize int) (Summary, error) {
+	var summary Summary
+	fail := func(row int64, symbol string, err error) {
+		summary.Failed++
+		summary.Errors = append(summary.Errors, RowError{Row: row, Symbol: symbol, Err: err})
+	}
+
+	var rows []int64
+	var items []models.CryptoItem
+	flush := func() error {
+		if len(items) == 0 {
+			return nil
+		}
+
+		writes := make([]mongo.WriteModel, len(items))
+		for i, item := range items {
+			writes[i] = mongo.NewUpdateOneModel().
+				SetFilter(bson.M{"_id": item.Id}).
+				SetUpdate(restoreUpdate(item)).
+				SetUpsert(true)
+		}
+
+		result, err := db.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+		if result != nil {
+			summary.Restored += result.UpsertedCount + result.MatchedCount
+		}
+
+		var bulkErr mongo.BulkWriteException
+		if errors.As(err, &bulkErr) && bulkErr.WriteConcernError == nil {
+			for _, writeErr := range bulkErr.WriteErrors {
+				fail(rows[writeErr.Index], items[writeErr.Index].Symbol, writeErr)
+			}
+			err = nil
+		}
+
+		rows, items = rows[:0], items[:0]
+		return err
+	}
+
+	for row := int64(1); ; row++ {
+		item, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return summary, fmt.Errorf("record %d: %w", row, err)
+		}
+
+		if err := Validate(item); err != nil {
+			fail(row, item.Symbol, err)
+			continue
+		}
+
+		item.VoteRate = item.Likes - item.Dislikes
+		item.Scores = ranking.Compute(item.Likes, item.Dislikes, item.UpdatedAt)
+		rows = append(rows, row)
+		items = append(items, item)
+		if len(items) >= batchSize {
+			if err := flush(); err != nil {
+				return summary, err
+			}
+		}
+	}
+
+	return summary, flush()
+}
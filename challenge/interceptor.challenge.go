@@ -0,0 +1,113 @@
+package challenge
+
+import (
+	"api/app/pb"
+	"api/apperrors"
+	"api/auth"
+	"context"
+	"errors"
+	"log"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	ChallengeHeader = "vote-challenge"
+	SolutionHeader  = "vote-solution"
+)
+
+var failures = map[error]string{
+	ErrInvalid:  "The vote challenge is not valid",
+	ErrExpired:  "The vote challenge has expired",
+	ErrTooWeak:  "The solution does not meet the difficulty of the vote challenge",
+	ErrReplayed: "The vote challenge was already used",
+}
+
+// proofOfWork reports whether the method behind a "/package.Service/Method"
+// name is marked with the (proof_of_work) option in the proto files.
+func proofOfWork(fullMethod string) bool {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", "."))
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return false
+	}
+
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return false
+	}
+
+	proofOfWork, _ := proto.GetExtension(method.Options(), pb.E_ProofOfWork).(bool)
+	return proofOfWork
+}
+
+// votes returns how many votes a request carries, each of which needs its
+// own solved challenge.
+func votes(req interface{}) int {
+	if batch, ok := req.(interface{ GetVotes() []*pb.Vote }); ok && len(batch.GetVotes()) > 1 {
+		return len(batch.GetVotes())
+	}
+
+	return 1
+}
+
+// values returns the values of a metadata key, splitting those a proxy
+// joined with commas.
+func values(md metadata.MD, key string) []string {
+	var all []string
+	for _, value := range md.Get(key) {
+		for _, part := range strings.Split(value, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				all = append(all, part)
+			}
+		}
+	}
+
+	return all
+}
+
+// UnaryServerInterceptor requires callers of proof_of_work methods to send a
+// solved challenge per vote, unless they carry the admin token. The
+// challenges are given back if the call fails, so it can be retried with
+// them. A nil issuer lets every call through.
+func UnaryServerInterceptor(issuer *Issuer, adminToken string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if issuer == nil || !proofOfWork(info.FullMethod) || auth.Authenticated(ctx, adminToken) {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		tokens, solutions := values(md, ChallengeHeader), values(md, SolutionHeader)
+		needed := votes(req)
+		if len(tokens) < needed || len(solutions) < needed {
+			return nil, apperrors.New(codes.Unauthenticated, apperrors.ReasonChallengeRequired,
+				"Anonymous votes need a solved challenge from GetVoteChallenge per vote in the "+ChallengeHeader+" and "+SolutionHeader+" metadata")
+		}
+		tokens, solutions = tokens[:needed], solutions[:needed]
+
+		err := issuer.VerifyAll(ctx, tokens, solutions)
+		for cause, message := range failures {
+			if errors.Is(err, cause) {
+				return nil, apperrors.New(codes.PermissionDenied, apperrors.ReasonChallengeFailed, message)
+			}
+		}
+		if err != nil {
+			return nil, apperrors.FromDB(err, "vote challenge", "")
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			if err := issuer.Release(context.WithoutCancel(ctx), tokens); err != nil {
+				log.Printf("Could not release vote challenges after %s failed: %v", info.FullMethod, err)
+			}
+		}
+
+		return resp, err
+	}
+}
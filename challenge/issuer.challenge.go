@@ -0,0 +1,256 @@
+package challenge
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math"
+	"math/bits"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// A token is a random id, the difficulty and the expiry, followed by their
+// HMAC-SHA256, so the server needs no state to check a challenge it issued.
+const (
+	idSize      = 16
+	payloadSize = idSize + 1 + 8
+	// loadWindow is the period over which the rate of solved challenges is
+	// measured.
+	loadWindow = 60
+)
+
+var (
+	ErrInvalid  = errors.New("the vote challenge is not valid")
+	ErrExpired  = errors.New("the vote challenge has expired")
+	ErrTooWeak  = errors.New("the solution does not meet the difficulty of the vote challenge")
+	ErrReplayed = errors.New("the vote challenge was already used")
+)
+
+type Challenge struct {
+	Token      string
+	Difficulty int
+	ExpiresAt  time.Time
+}
+
+// Issuer hands out hashcash challenges and checks their solutions. The
+// difficulty starts at MinDifficulty and rises by one bit, doubling the work,
+// every time the rate of challenges solved doubles past TargetRate per
+// second, up to MaxDifficulty. Issued challenges do not count, as asking for
+// one is free.
+type Issuer struct {
+	Secret        []byte
+	TTL           time.Duration
+	MinDifficulty int
+	MaxDifficulty int
+	TargetRate    float64
+	// Spent holds the challenges already used, until they expire.
+	Spent *mongo.Collection
+
+	mu     sync.Mutex
+	solved map[int64]int
+}
+
+func NewIssuer(secret []byte, ttl time.Duration, minDifficulty, maxDifficulty int, targetRate float64, db *mongo.Database) *Issuer {
+	return &Issuer{
+		Secret:        secret,
+		TTL:           ttl,
+		MinDifficulty: minDifficulty,
+		MaxDifficulty: maxDifficulty,
+		TargetRate:    targetRate,
+		Spent:         db.Collection("spent_challenges"),
+		solved:        map[int64]int{},
+	}
+}
+
+// record counts challenges solved at now.
+func (i *Issuer) record(now time.Time, solved int) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.solved[now.Unix()] += solved
+}
+
+// difficulty returns the difficulty the load at now calls for.
+func (i *Issuer) difficulty(now time.Time) int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	second := now.Unix()
+	total := 0
+	for at, count := range i.solved {
+		if at <= second-loadWindow {
+			delete(i.solved, at)
+		} else {
+			total += count
+		}
+	}
+
+	difficulty := i.MinDifficulty
+	if rate := float64(total) / loadWindow; i.TargetRate > 0 && rate > i.TargetRate {
+		difficulty += int(math.Ceil(math.Log2(rate / i.TargetRate)))
+	}
+	if difficulty > i.MaxDifficulty {
+		difficulty = i.MaxDifficulty
+	}
+
+	return difficulty
+}
+
+func (i *Issuer) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, i.Secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+func (i *Issuer) Issue() (Challenge, error) {
+	now := time.Now()
+	challenge := Challenge{Difficulty: i.difficulty(now), ExpiresAt: now.Add(i.TTL).Truncate(time.Second)}
+
+	payload := make([]byte, payloadSize)
+	if _, err := rand.Read(payload[:idSize]); err != nil {
+		return Challenge{}, err
+	}
+	payload[idSize] = byte(challenge.Difficulty)
+	binary.BigEndian.PutUint64(payload[idSize+1:], uint64(challenge.ExpiresAt.Unix()))
+
+	challenge.Token = base64.RawURLEncoding.EncodeToString(append(payload, i.sign(payload)...))
+	return challenge, nil
+}
+
+// leadingZeros counts the leading zero bits of the SHA-256 of
+// "<token>:<solution>".
+func leadingZeros(token, solution string) int {
+	hash := sha256.Sum256([]byte(token + ":" + solution))
+	zeros := 0
+	for _, b := range hash {
+		zeros += bits.LeadingZeros8(b)
+		if b != 0 {
+			break
+		}
+	}
+
+	return zeros
+}
+
+// Solve finds a solution to a challenge by brute force, as a client would.
+func Solve(token string, difficulty int) string {
+	for counter := uint64(0); ; counter++ {
+		solution := strconv.FormatUint(counter, 36)
+		if leadingZeros(token, solution) >= difficulty {
+			return solution
+		}
+	}
+}
+
+// check verifies a solution without spending the challenge, and returns
+// the challenge id and expiry.
+func (i *Issuer) check(token, solution string, now time.Time) (string, time.Time, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(raw) != payloadSize+sha256.Size {
+		return "", time.Time{}, ErrInvalid
+	}
+	payload := raw[:payloadSize]
+	if !hmac.Equal(raw[payloadSize:], i.sign(payload)) {
+		return "", time.Time{}, ErrInvalid
+	}
+
+	expiresAt := time.Unix(int64(binary.BigEndian.Uint64(payload[idSize+1:])), 0)
+	if !now.Before(expiresAt) {
+		return "", time.Time{}, ErrExpired
+	}
+	if leadingZeros(token, solution) < int(payload[idSize]) {
+		return "", time.Time{}, ErrTooWeak
+	}
+
+	return hex.EncodeToString(payload[:idSize]), expiresAt, nil
+}
+
+// Verify checks a solution and spends the challenge, so it cannot be used
+// again. Database errors are returned unchanged.
+func (i *Issuer) Verify(ctx context.Context, token, solution string) error {
+	return i.VerifyAll(ctx, []string{token}, []string{solution})
+}
+
+// VerifyAll checks a solution for each token and spends them all, or none
+// if any fails. Database errors are returned unchanged.
+func (i *Issuer) VerifyAll(ctx context.Context, tokens, solutions []string) error {
+	now := time.Now()
+	ids := make([]string, len(tokens))
+	spent := make([]interface{}, len(tokens))
+	seen := map[string]bool{}
+	for n, token := range tokens {
+		id, expiresAt, err := i.check(token, solutions[n], now)
+		if err != nil {
+			return err
+		}
+		if seen[id] {
+			return ErrReplayed
+		}
+		seen[id] = true
+		ids[n] = id
+		spent[n] = bson.M{"_id": id, "expiresAt": expiresAt}
+	}
+
+	_, err := i.Spent.InsertMany(ctx, spent, options.InsertMany().SetOrdered(false))
+	var bulk mongo.BulkWriteException
+	if errors.As(err, &bulk) && bulk.WriteConcernError == nil {
+		// give back those this call spent before reporting the others
+		replayed := map[int]bool{}
+		for _, writeErr := range bulk.WriteErrors {
+			if !mongo.IsDuplicateKeyError(writeErr) {
+				return err
+			}
+			replayed[writeErr.Index] = true
+		}
+		var fresh []string
+		for n, id := range ids {
+			if !replayed[n] {
+				fresh = append(fresh, id)
+			}
+		}
+		if err := i.release(ctx, fresh); err != nil {
+			return err
+		}
+		return ErrReplayed
+	}
+	if err != nil {
+		return err
+	}
+
+	i.record(now, len(ids))
+	return nil
+}
+
+// Release returns spent challenges, so a call that failed after spending
+// them can be retried with the same solutions.
+func (i *Issuer) Release(ctx context.Context, tokens []string) error {
+	ids := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		raw, err := base64.RawURLEncoding.DecodeString(token)
+		if err == nil && len(raw) == payloadSize+sha256.Size {
+			ids = append(ids, hex.EncodeToString(raw[:idSize]))
+		}
+	}
+
+	return i.release(ctx, ids)
+}
+
+func (i *Issuer) release(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := i.Spent.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	return err
+}
@@ -0,0 +1,204 @@
+package challenge
+
+import (
+	"api/app/pb"
+	"api/apperrors"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newIssuer(spent *mongo.Collection) *Issuer {
+	return &Issuer{
+		Secret:        []byte("secret"),
+		TTL:           time.Minute,
+		MinDifficulty: 2,
+		MaxDifficulty: 10,
+		TargetRate:    1,
+		Spent:         spent,
+		solved:        map[int64]int{},
+	}
+}
+
+// solved issues n challenges and solves them.
+func solved(t testing.TB, issuer *Issuer, n int) (tokens, solutions []string) {
+	for i := 0; i < n; i++ {
+		challenge, err := issuer.Issue()
+		if err != nil {
+			t.Fatal(err)
+		}
+		tokens = append(tokens, challenge.Token)
+		solutions = append(solutions, Solve(challenge.Token, challenge.Difficulty))
+	}
+
+	return tokens, solutions
+}
+
+// commands returns the names of the commands sent to the mock deployment.
+func commands(mt *mtest.T) []string {
+	var names []string
+	for started := mt.GetStartedEvent(); started != nil; started = mt.GetStartedEvent() {
+		names = append(names, started.CommandName)
+	}
+
+	return names
+}
+
+func reason(err error) string {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info.Reason
+		}
+	}
+
+	return ""
+}
+
+func TestDifficultyFollowsSolvedChallenges(t *testing.T) {
+	issuer := newIssuer(nil)
+	for i := 0; i < 1000; i++ {
+		if _, err := issuer.Issue(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := issuer.difficulty(time.Now()); got != 2 {
+		t.Fatalf("difficulty %d after issuing 1000 unsolved challenges, want 2", got)
+	}
+
+	// 4 solutions per second over the window, twice doubling the target
+	now := time.Now()
+	issuer.record(now, 4*loadWindow)
+	if got := issuer.difficulty(now); got != 4 {
+		t.Fatalf("difficulty %d at 4 solutions per second, want 4", got)
+	}
+	if got := issuer.difficulty(now.Add(2 * loadWindow * time.Second)); got != 2 {
+		t.Fatalf("difficulty %d once the window passed, want 2", got)
+	}
+}
+
+func TestVerifyAll(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("spends every challenge", func(mt *mtest.T) {
+		issuer := newIssuer(mt.Coll)
+		tokens, solutions := solved(mt, issuer, 3)
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 3}))
+
+		if err := issuer.VerifyAll(context.Background(), tokens, solutions); err != nil {
+			mt.Fatal(err)
+		}
+		if got := issuer.difficulty(time.Now()); got != 2 {
+			mt.Fatalf("difficulty %d after 3 solutions, want 2", got)
+		}
+		if total := issuer.solved[time.Now().Unix()] + issuer.solved[time.Now().Unix()-1]; total != 3 {
+			mt.Fatalf("recorded %d solutions, want 3", total)
+		}
+	})
+
+	mt.Run("rejects a challenge sent twice", func(mt *mtest.T) {
+		issuer := newIssuer(mt.Coll)
+		tokens, solutions := solved(mt, issuer, 1)
+
+		err := issuer.VerifyAll(context.Background(), append(tokens, tokens[0]), append(solutions, solutions[0]))
+		if !errors.Is(err, ErrReplayed) {
+			mt.Fatalf("got %v, want ErrReplayed", err)
+		}
+		if names := commands(mt); len(names) != 0 {
+			mt.Fatalf("sent %v, want nothing spent", names)
+		}
+	})
+
+	mt.Run("gives back the others when one was used", func(mt *mtest.T) {
+		issuer := newIssuer(mt.Coll)
+		tokens, solutions := solved(mt, issuer, 2)
+		mt.AddMockResponses(
+			mtest.CreateWriteErrorsResponse(mtest.WriteError{Index: 1, Code: 11000, Message: "duplicate key"}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}),
+		)
+
+		if err := issuer.VerifyAll(context.Background(), tokens, solutions); !errors.Is(err, ErrReplayed) {
+			mt.Fatalf("got %v, want ErrReplayed", err)
+		}
+		if names := commands(mt); len(names) != 2 || names[1] != "delete" {
+			mt.Fatalf("sent %v, want the fresh challenge deleted", names)
+		}
+	})
+
+	mt.Run("rejects a weak solution", func(mt *mtest.T) {
+		issuer := newIssuer(mt.Coll)
+		issuer.MinDifficulty = 20
+		tokens, _ := solved(mt, newIssuer(mt.Coll), 1)
+		challenge, err := issuer.Issue()
+		if err != nil {
+			mt.Fatal(err)
+		}
+
+		err = issuer.VerifyAll(context.Background(), []string{tokens[0], challenge.Token}, []string{Solve(tokens[0], 2), "0"})
+		if !errors.Is(err, ErrTooWeak) {
+			mt.Fatalf("got %v, want ErrTooWeak", err)
+		}
+	})
+}
+
+func TestInterceptorNeedsOneChallengePerVote(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	info := &grpc.UnaryServerInfo{FullMethod: "/crypto.CryptoService/BatchVote"}
+	batch := &pb.BatchVoteRequest{Votes: []*pb.Vote{
+		{Id: "65f000000000000000000001", Direction: pb.VoteDirection_LIKE},
+		{Id: "65f000000000000000000002", Direction: pb.VoteDirection_REMOVE_LIKE},
+		{Id: "65f000000000000000000003", Direction: pb.VoteDirection_DISLIKE},
+	}}
+	incoming := func(tokens, solutions []string) context.Context {
+		md := metadata.MD{}
+		md.Append(ChallengeHeader, tokens...)
+		md.Append(SolutionHeader, solutions...)
+		return metadata.NewIncomingContext(context.Background(), md)
+	}
+
+	mt.Run("too few challenges", func(mt *mtest.T) {
+		issuer := newIssuer(mt.Coll)
+		tokens, solutions := solved(mt, issuer, 2)
+		interceptor := UnaryServerInterceptor(issuer, "admin")
+
+		_, err := interceptor(incoming(tokens, solutions), batch, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			mt.Fatal("the batch ran with two challenges for three votes")
+			return nil, nil
+		})
+		if got := reason(err); got != apperrors.ReasonChallengeRequired {
+			mt.Fatalf("got %v, want %s", err, apperrors.ReasonChallengeRequired)
+		}
+	})
+
+	mt.Run("failed call gives the challenges back", func(mt *mtest.T) {
+		issuer := newIssuer(mt.Coll)
+		tokens, solutions := solved(mt, issuer, 3)
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 3}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 3}),
+		)
+		interceptor := UnaryServerInterceptor(issuer, "admin")
+
+		// a proxy may join repeated headers with commas
+		joined := metadata.Pairs(ChallengeHeader, tokens[0]+", "+tokens[1], ChallengeHeader, tokens[2])
+		joined.Append(SolutionHeader, solutions...)
+		failure := apperrors.NotFound("crypto", "65f000000000000000000002")
+		_, err := interceptor(metadata.NewIncomingContext(context.Background(), joined), batch, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, failure
+		})
+		if err != failure {
+			mt.Fatalf("got %v, want the handler's error", err)
+		}
+		if names := commands(mt); len(names) != 2 || names[0] != "insert" || names[1] != "delete" {
+			mt.Fatalf("sent %v, want the challenges spent then given back", names)
+		}
+	})
+}
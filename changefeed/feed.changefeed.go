@@ -0,0 +1,235 @@
+package changefeed
+
+import (
+	"api/events"
+	"api/models"
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// saveEvery bounds how many changes are replayed after a restart while
+	// the stream is busy; an idle stream saves its position right away.
+	saveEvery  = 100
+	retryDelay = 5 * time.Second
+)
+
+// change is the part of a change stream document the feed reads.
+type change struct {
+	OperationType string              `bson:"operationType"`
+	ClusterTime   primitive.Timestamp `bson:"clusterTime"`
+	WallTime      time.Time           `bson:"wallTime"`
+	DocumentKey   struct {
+		Id primitive.ObjectID `bson:"_id"`
+	} `bson:"documentKey"`
+	FullDocument             *models.CryptoItem `bson:"fullDocument"`
+	FullDocumentBeforeChange *models.CryptoItem `bson:"fullDocumentBeforeChange"`
+	UpdateDescription        struct {
+		UpdatedFields bson.M   `bson:"updatedFields"`
+		RemovedFields []string `bson:"removedFields"`
+	} `bson:"updateDescription"`
+}
+
+// Feed publishes every change to the crypto collection, whether made by this
+// instance, another one or directly in the database, to Bus. The position
+// read up to is saved under Name, so a restarted feed resumes where it
+// stopped; events are delivered at least once.
+type Feed struct {
+	Cryptos *mongo.Collection
+	Tokens  *mongo.Collection
+	Name    string
+	Bus     *events.Bus
+}
+
+func NewFeed(cryptos *mongo.Collection, name string, bus *events.Bus) *Feed {
+	return &Feed{
+		Cryptos: cryptos,
+		Tokens:  cryptos.Database().Collection("resume_tokens"),
+		Name:    name,
+		Bus:     bus,
+	}
+}
+
+// Run follows the change stream until ctx is done, reopening it after
+// errors. When the saved position is no longer in the oplog, the feed
+// starts over from the present and publishes an Imported event so
+// subscribers reload what they cache.
+func (f *Feed) Run(ctx context.Context) {
+	for {
+		err := f.watch(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err == nil || historyLost(err) {
+			if err != nil {
+				log.Printf("Change feed %s fell behind the oplog, starting over: %v", f.Name, err)
+			}
+			if _, err := f.Tokens.DeleteOne(ctx, bson.M{"_id": f.Name}); err != nil {
+				log.Printf("Could not reset change feed %s: %v", f.Name, err)
+			}
+			f.Bus.Publish(events.Event{Type: events.Imported})
+			continue
+		}
+
+		log.Printf("Change feed %s stopped, retrying in %s: %v", f.Name, retryDelay, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryDelay):
+		}
+	}
+}
+
+// watch publishes changes until the stream fails. It returns nil when the
+// collection was dropped or renamed, which ends the stream for good.
+func (f *Feed) watch(ctx context.Context) error {
+	var saved models.ResumeToken
+	err := f.Tokens.FindOne(ctx, bson.M{"_id": f.Name}).Decode(&saved)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return err
+	}
+
+	// the post-image is the document as the change left it, unlike a
+	// lookup, which may already see later changes and skew the deltas
+	opts := options.ChangeStream().
+		SetFullDocument(options.WhenAvailable).
+		SetFullDocumentBeforeChange(options.WhenAvailable)
+	if saved.Token != nil {
+		opts.SetStartAfter(saved.Token)
+	}
+
+	stream, err := f.Cryptos.Watch(ctx, mongo.Pipeline{}, opts)
+	if err != nil {
+		return err
+	}
+
+	defer stream.Close(context.Background())
+
+	token := saved.Token
+	unsaved := 0
+	for {
+		if !stream.TryNext(ctx) {
+			if err := stream.Err(); err != nil {
+				return err
+			}
+			if token, err = f.save(ctx, token, stream.ResumeToken()); err != nil {
+				return err
+			}
+			unsaved = 0
+			if !stream.Next(ctx) {
+				return stream.Err()
+			}
+		}
+
+		var c change
+		if err := stream.Decode(&c); err != nil {
+			return err
+		}
+		if c.OperationType == "invalidate" {
+			return nil
+		}
+		if event, ok := eventOf(c); ok {
+			f.Bus.Publish(event)
+		}
+
+		if unsaved++; unsaved >= saveEvery {
+			if token, err = f.save(ctx, token, stream.ResumeToken()); err != nil {
+				return err
+			}
+			unsaved = 0
+		}
+	}
+}
+
+// save stores token unless it is the one already saved, and returns the
+// token now saved.
+func (f *Feed) save(ctx context.Context, saved, token bson.Raw) (bson.Raw, error) {
+	if token == nil || bytes.Equal(saved, token) {
+		return saved, nil
+	}
+
+	token = append(bson.Raw(nil), token...)
+	update := bson.M{"$set": bson.M{"token": token, "updatedAt": time.Now()}}
+	_, err := f.Tokens.UpdateOne(ctx, bson.M{"_id": f.Name}, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return saved, err
+	}
+
+	return token, nil
+}
+
+func historyLost(err error) bool {
+	var serverErr mongo.ServerError
+	if !errors.As(err, &serverErr) {
+		return false
+	}
+
+	// ChangeStreamHistoryLost and ChangeStreamFatalError
+	return serverErr.HasErrorCode(286) || serverErr.HasErrorCode(280)
+}
+
+// eventOf translates a change into the event a write made through the API
+// would have published.
+func eventOf(c change) (events.Event, bool) {
+	event := events.Event{CryptoId: c.DocumentKey.Id.Hex(), OccurredAt: c.WallTime}
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Unix(int64(c.ClusterTime.T), 0)
+	}
+
+	switch c.OperationType {
+	case "insert":
+		event.Type = events.Created
+	case "replace":
+		event.Type = events.Updated
+	case "update":
+		event.Type = updateType(c)
+	case "delete":
+		event.Type = events.Purged
+		return event, true
+	default:
+		return event, false
+	}
+
+	// the post-image is missing when the collection does not record images
+	// or they expired; there is nothing to publish
+	if c.FullDocument == nil {
+		return event, false
+	}
+	event.Crypto = *c.FullDocument
+
+	if event.Type == events.Voted && c.FullDocumentBeforeChange != nil {
+		event.LikesDelta = c.FullDocument.Likes - c.FullDocumentBeforeChange.Likes
+		event.DislikesDelta = c.FullDocument.Dislikes - c.FullDocumentBeforeChange.Dislikes
+	}
+
+	return event, true
+}
+
+func updateType(c change) events.Type {
+	fields := c.UpdateDescription.UpdatedFields
+	if deletedAt, ok := fields["deletedAt"]; ok && deletedAt != nil {
+		return events.Deleted
+	}
+	for _, field := range c.UpdateDescription.RemovedFields {
+		if field == "deletedAt" {
+			return events.Restored
+		}
+	}
+
+	_, likes := fields["likes"]
+	_, dislikes := fields["dislikes"]
+	if likes || dislikes {
+		return events.Voted
+	}
+
+	return events.Updated
+}
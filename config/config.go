@@ -1,6 +1,10 @@
 package config
 
 import (
+	"os"
+	"strconv"
+	"time"
+
 	"github.com/joho/godotenv"
 )
 
@@ -12,3 +16,39 @@ func LoadEnv() error {
 
 	return nil
 }
+
+func GetString(key, fallback string) string {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+
+	return value
+}
+
+func GetDuration(key string, fallback time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+
+	return value
+}
+
+func GetInt(key string, fallback int64) int64 {
+	value, err := strconv.ParseInt(os.Getenv(key), 10, 64)
+	if err != nil {
+		return fallback
+	}
+
+	return value
+}
+
+func GetFloat(key string, fallback float64) float64 {
+	value, err := strconv.ParseFloat(os.Getenv(key), 64)
+	if err != nil {
+		return fallback
+	}
+
+	return value
+}
@@ -0,0 +1,502 @@
+package controllers
+
+import (
+	"api/app/pb"
+	"api/apperrors"
+	"api/events"
+	"api/fraud"
+	"api/models"
+	"api/ranking"
+	"api/votebuffer"
+	"context"
+	"errors"
+	"time"
+
+	bson "go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errBatchAborted = errors.New("not created because another item in the batch failed")
+
+// findByIds loads the non-deleted cryptos among ids with a single query.
+func (s *CryptoServiceServer) findByIds(ctx context.Context, ids []bson.ObjectID) (map[bson.ObjectID]models.CryptoItem, error) {
+	cursor, err := s.Db.Find(ctx, notDeleted(bson.M{"_id": bson.M{"$in": ids}}))
+	if err != nil {
+		return nil, err
+	}
+
+	defer cursor.Close(ctx)
+
+	found := make(map[bson.ObjectID]models.CryptoItem, len(ids))
+	for cursor.Next(ctx) {
+		var data models.CryptoItem
+		if err := cursor.Decode(&data); err != nil {
+			return nil, err
+		}
+		found[data.Id] = data
+	}
+
+	return found, cursor.Err()
+}
+
+func (s *CryptoServiceServer) BatchGetCryptos(ctx context.Context, req *pb.BatchGetCryptosRequest) (*pb.BatchGetCryptosResponse, error) {
+	ids := make([]bson.ObjectID, len(req.GetIds()))
+	for i, id := range req.GetIds() {
+		objectId, err := bson.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, apperrors.InvalidArgument("ids", "must be valid ObjectIds")
+		}
+		ids[i] = objectId
+	}
+
+	found, err := s.findByIds(ctx, ids)
+	if err != nil {
+		return nil, apperrors.FromDB(err, "crypto", "")
+	}
+
+	results := make([]*pb.BatchGetCryptoResult, len(ids))
+	for i, objectId := range ids {
+		results[i] = &pb.BatchGetCryptoResult{Id: objectId.Hex(), NotFound: true}
+		if data, ok := found[objectId]; ok {
+			results[i].Crypto = cryptoToProto(s.withPending(data))
+			results[i].NotFound = false
+		}
+	}
+
+	return &pb.BatchGetCryptosResponse{
+		Results: results,
+	}, nil
+}
+
+// conflicts reports, per item, a symbol or slug already used by another item
+// of the batch or by a stored crypto, telling apart the ones in the trash.
+func (s *CryptoServiceServer) conflicts(ctx context.Context, items []models.CryptoItem, errs []error) error {
+	symbols := map[string]bool{}
+	slugs := map[string]bool{}
+	for i, data := range items {
+		if errs[i] != nil {
+			continue
+		}
+
+		switch {
+		case symbols[data.Symbol]:
+			errs[i] = apperrors.AlreadyExists("crypto", "symbol", data.Symbol)
+		case slugs[data.Slug]:
+			errs[i] = apperrors.AlreadyExists("crypto", "slug", data.Slug)
+		}
+		symbols[data.Symbol] = true
+		slugs[data.Slug] = true
+	}
+
+	filter := bson.M{"$or": bson.A{
+		bson.M{"symbol": bson.M{"$in": keys(symbols)}},
+		bson.M{"slug": bson.M{"$in": keys(slugs)}},
+	}}
+	cursor, err := s.Db.Find(ctx, filter, options.Find().SetProjection(bson.M{"symbol": 1, "slug": 1, "deletedAt": 1}))
+	if err != nil {
+		return err
+	}
+
+	defer cursor.Close(ctx)
+
+	existingSymbols := map[string]models.CryptoItem{}
+	existingSlugs := map[string]models.CryptoItem{}
+	for cursor.Next(ctx) {
+		var data models.CryptoItem
+		if err := cursor.Decode(&data); err != nil {
+			return err
+		}
+		existingSymbols[data.Symbol] = data
+		existingSlugs[data.Slug] = data
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+
+	for i, data := range items {
+		if errs[i] != nil {
+			continue
+		}
+		if holder, ok := existingSymbols[data.Symbol]; ok {
+			errs[i] = conflictError("symbol", data.Symbol, holder)
+		} else if holder, ok := existingSlugs[data.Slug]; ok {
+			errs[i] = conflictError("slug", data.Slug, holder)
+		}
+	}
+
+	return nil
+}
+
+func keys(set map[string]bool) []string {
+	result := make([]string, 0, len(set))
+	for key := range set {
+		result = append(result, key)
+	}
+
+	return result
+}
+
+func (s *CryptoServiceServer) BatchCreateCryptos(ctx context.Context, req *pb.BatchCreateCryptosRequest) (*pb.BatchCreateCryptosResponse, error) {
+	// without a transaction a failed insert would leave the earlier ones
+	// visible until they are removed again
+	if !req.GetPartial() && !s.Transactions {
+		return nil, apperrors.New(codes.FailedPrecondition, apperrors.ReasonNoTransactions, "All-or-nothing batches need a replica set; set partial instead")
+	}
+
+	items := make([]models.CryptoItem, len(req.GetItems()))
+	errs := make([]error, len(items))
+	for i, item := range req.GetItems() {
+		items[i], errs[i] = newCryptoItem(item)
+		items[i].Id = bson.NewObjectID()
+	}
+
+	if err := s.conflicts(ctx, items, errs); err != nil {
+		return nil, apperrors.FromDB(err, "crypto", "")
+	}
+
+	var pending []int
+	for i := range items {
+		if errs[i] == nil {
+			pending = append(pending, i)
+		}
+	}
+
+	if !req.GetPartial() && len(pending) < len(items) {
+		for _, i := range pending {
+			errs[i] = errBatchAborted
+		}
+		pending = nil
+	}
+
+	transaction := s.Outbox != nil || !req.GetPartial()
+	for len(pending) > 0 {
+		err := s.commitIn(ctx, transaction, func(ctx context.Context) ([]events.Event, error) {
+			docs := make([]interface{}, len(pending))
+			created := make([]events.Event, len(pending))
+			for j, i := range pending {
+				docs[j] = items[i]
+				created[j] = cryptoEvent(events.Created, items[i])
+			}
+
+			if _, err := s.Db.InsertMany(ctx, docs, options.InsertMany().SetOrdered(!req.GetPartial())); err != nil {
+				return nil, err
+			}
+			return created, nil
+		})
+		if err == nil {
+			break
+		}
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		if err := s.insertFailed(ctx, err, req.GetPartial(), items, errs, pending); err != nil {
+			return nil, err
+		}
+
+		var remaining []int
+		for _, i := range pending {
+			if errs[i] == nil {
+				remaining = append(remaining, i)
+			}
+		}
+
+		if !transaction {
+			// without a transaction the other items were inserted despite the failure
+			for _, i := range remaining {
+				s.publish(ctx, cryptoEvent(events.Created, items[i]))
+			}
+			break
+		}
+		// the transaction rolled every insert back: retry without the rejected items
+		pending = remaining
+	}
+
+	results := make([]*pb.BatchCreateCryptoResult, len(items))
+	for i, data := range items {
+		results[i] = &pb.BatchCreateCryptoResult{}
+		if errs[i] != nil {
+			results[i].Error = status.Convert(errs[i]).Message()
+		} else {
+			results[i].Crypto = cryptoToProto(data)
+		}
+	}
+
+	return &pb.BatchCreateCryptosResponse{
+		Results: results,
+	}, nil
+}
+
+// insertFailed records per-item errors after InsertMany failed. In partial mode
+// only the rejected documents fail; otherwise the transaction rolled every
+// insert back and the other items are aborted.
+func (s *CryptoServiceServer) insertFailed(ctx context.Context, err error, partial bool, items []models.CryptoItem, errs []error, pending []int) error {
+	var bulkErr mongo.BulkWriteException
+	if !errors.As(err, &bulkErr) {
+		return apperrors.FromDB(err, "crypto", "")
+	}
+
+	for _, writeErr := range bulkErr.WriteErrors {
+		i := pending[writeErr.Index]
+		if mongo.IsDuplicateKeyError(writeErr) {
+			errs[i] = s.duplicateError(ctx, writeErr, items[i].Symbol, items[i].Slug)
+		} else {
+			errs[i] = apperrors.FromDB(writeErr, "crypto", items[i].Symbol)
+		}
+	}
+
+	if partial {
+		return nil
+	}
+
+	for _, i := range pending {
+		if errs[i] == nil {
+			errs[i] = errBatchAborted
+		}
+	}
+
+	return nil
+}
+
+// votePipeline applies like/dislike deltas atomically, never letting a
+// counter drop below zero, and keeps voteRate and the scores in sync.
+func votePipeline(likes, dislikes int64) mongo.Pipeline {
+	return mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"likes":    bson.M{"$max": bson.A{0, bson.M{"$add": bson.A{"$likes", likes}}}},
+			"dislikes": bson.M{"$max": bson.A{0, bson.M{"$add": bson.A{"$dislikes", dislikes}}}},
+		}}},
+		{{Key: "$set", Value: bson.M{
+			"voteRate":  bson.M{"$subtract": bson.A{"$likes", "$dislikes"}},
+			"updatedAt": time.Now(),
+		}}},
+		ranking.Stage(),
+	}
+}
+
+// updateVote applies like/dislike deltas to a live crypto with votePipeline
+// and returns it as the update found and left it. The update returns the
+// document it changed, so the deltas between the two are the ones applied
+// even while other votes are written.
+func (s *CryptoServiceServer) updateVote(ctx context.Context, objectId bson.ObjectID, likes, dislikes int64) (before, after models.CryptoItem, err error) {
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.Before)
+	result := s.Db.FindOneAndUpdate(ctx, notDeleted(bson.M{"_id": objectId}), votePipeline(likes, dislikes), opts)
+	if err := result.Decode(&before); err != nil {
+		return before, after, err
+	}
+
+	// the pipeline clamps the counters at zero
+	after = before
+	after.Likes, after.Dislikes = before.Likes+likes, before.Dislikes+dislikes
+	if after.Likes < 0 {
+		after.Likes = 0
+	}
+	if after.Dislikes < 0 {
+		after.Dislikes = 0
+	}
+	after.VoteRate = after.Likes - after.Dislikes
+	after.UpdatedAt = time.Now()
+	after.Scores = ranking.Compute(after.Likes, after.Dislikes, after.UpdatedAt)
+	return before, after, nil
+}
+
+func voteDeltas(direction pb.VoteDirection) (likes, dislikes int64) {
+	switch direction {
+	case pb.VoteDirection_LIKE:
+		return 1, 0
+	case pb.VoteDirection_REMOVE_LIKE:
+		return -1, 0
+	case pb.VoteDirection_DISLIKE:
+		return 0, 1
+	case pb.VoteDirection_REMOVE_DISLIKE:
+		return 0, -1
+	}
+
+	return 0, 0
+}
+
+func (s *CryptoServiceServer) BatchVote(ctx context.Context, req *pb.BatchVoteRequest) (*pb.BatchVoteResponse, error) {
+	type delta struct{ likes, dislikes int64 }
+	type heldVote struct {
+		objectId bson.ObjectID
+		vote     fraud.Vote
+		verdict  fraud.Verdict
+	}
+
+	ids := make([]bson.ObjectID, len(req.GetVotes()))
+	var held, flagged []heldVote
+	deltas := map[bson.ObjectID]*delta{}
+	var order []bson.ObjectID
+	for i, vote := range req.GetVotes() {
+		objectId, err := bson.ObjectIDFromHex(vote.GetId())
+		if err != nil {
+			return nil, apperrors.InvalidArgument("votes", "must reference valid ObjectIds")
+		}
+		ids[i] = objectId
+
+		likes, dislikes := voteDeltas(vote.GetDirection())
+		screened, verdict := s.screenVote(ctx, objectId, likes, dislikes, "", time.Time{})
+		if !verdict.Counted() {
+			held = append(held, heldVote{objectId, screened, verdict})
+			continue
+		}
+		if verdict.Action == fraud.Flag {
+			flagged = append(flagged, heldVote{objectId, screened, verdict})
+		}
+
+		if deltas[objectId] == nil {
+			deltas[objectId] = &delta{}
+			order = append(order, objectId)
+		}
+		deltas[objectId].likes += likes
+		deltas[objectId].dislikes += dislikes
+	}
+
+	// settle records the suspicious votes once the counted ones made the
+	// applied changes. Held votes are reported as if they were counted.
+	settle := func(found map[bson.ObjectID]models.CryptoItem, applied map[bson.ObjectID]delta) error {
+		// what the clamp at zero cut from the removals on a crypto is taken
+		// from its flagged removals first, so rejecting them never gives
+		// back more than was taken
+		cuts := map[bson.ObjectID]*delta{}
+		for objectId, d := range deltas {
+			cuts[objectId] = &delta{applied[objectId].likes - d.likes, applied[objectId].dislikes - d.dislikes}
+		}
+		for _, h := range flagged {
+			if _, ok := found[h.objectId]; ok {
+				cut := cuts[h.objectId]
+				s.flagVote(ctx, h.objectId, h.vote, h.verdict, uncut(h.vote.Likes, &cut.likes), uncut(h.vote.Dislikes, &cut.dislikes))
+			}
+		}
+		for _, h := range held {
+			data, err := s.holdVote(ctx, h.objectId, h.vote, h.verdict)
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if _, ok := found[h.objectId]; !ok {
+				found[h.objectId] = data
+			}
+		}
+		return nil
+	}
+
+	if s.Votes != nil {
+		found, err := s.findByIds(ctx, order)
+		if err != nil {
+			return nil, apperrors.FromDB(err, "crypto", "")
+		}
+		applied := map[bson.ObjectID]delta{}
+		for objectId, data := range found {
+			d := deltas[objectId]
+			var change votebuffer.Delta
+			if found[objectId], change, err = s.bufferVote(data, d.likes, d.dislikes); err != nil {
+				return nil, err
+			}
+			applied[objectId] = delta{change.Likes, change.Dislikes}
+		}
+		if err := settle(found, applied); err != nil {
+			return nil, err
+		}
+
+		return &pb.BatchVoteResponse{
+			Results: batchVoteResults(ids, found),
+		}, nil
+	}
+
+	found := map[bson.ObjectID]models.CryptoItem{}
+	if len(order) == 0 {
+		if err := settle(found, nil); err != nil {
+			return nil, err
+		}
+		return &pb.BatchVoteResponse{
+			Results: batchVoteResults(ids, found),
+		}, nil
+	}
+
+	var before map[bson.ObjectID]models.CryptoItem
+	err := s.commit(ctx, func(ctx context.Context) ([]events.Event, error) {
+		var err error
+		before, err = s.findByIds(ctx, order)
+		if err != nil {
+			return nil, apperrors.FromDB(err, "crypto", "")
+		}
+
+		writes := make([]mongo.WriteModel, 0, len(order))
+		for _, objectId := range order {
+			d := deltas[objectId]
+			writes = append(writes, mongo.NewUpdateOneModel().
+				SetFilter(notDeleted(bson.M{"_id": objectId})).
+				SetUpdate(votePipeline(d.likes, d.dislikes)))
+		}
+
+		if _, err := s.Db.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false)); err != nil {
+			return nil, apperrors.FromDB(err, "crypto", "")
+		}
+
+		found, err = s.findByIds(ctx, order)
+		if err != nil {
+			return nil, apperrors.FromDB(err, "crypto", "")
+		}
+
+		voted := make([]events.Event, 0, len(found))
+		for objectId, data := range found {
+			prev, ok := before[objectId]
+			if !ok {
+				prev = data
+			}
+			voted = append(voted, s.voteEvent(ctx, prev, data))
+		}
+		return voted, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	applied := map[bson.ObjectID]delta{}
+	for objectId, data := range found {
+		if prev, ok := before[objectId]; ok {
+			applied[objectId] = delta{data.Likes - prev.Likes, data.Dislikes - prev.Dislikes}
+		}
+	}
+	if err := settle(found, applied); err != nil {
+		return nil, err
+	}
+
+	return &pb.BatchVoteResponse{
+		Results: batchVoteResults(ids, found),
+	}, nil
+}
+
+// uncut returns what is left of a removal once cut, what the clamp at zero
+// dropped from the removals on its crypto, is taken from it, and takes that
+// from cut.
+func uncut(amount int64, cut *int64) int64 {
+	if amount >= 0 || *cut <= 0 {
+		return amount
+	}
+
+	taken := *cut
+	if taken > -amount {
+		taken = -amount
+	}
+	*cut -= taken
+	return amount + taken
+}
+
+// batchVoteResults reports, in request order, the crypto each vote landed on.
+func batchVoteResults(ids []bson.ObjectID, found map[bson.ObjectID]models.CryptoItem) []*pb.BatchVoteResult {
+	results := make([]*pb.BatchVoteResult, len(ids))
+	for i, objectId := range ids {
+		results[i] = &pb.BatchVoteResult{Id: objectId.Hex(), NotFound: true}
+		if data, ok := found[objectId]; ok {
+			results[i].Crypto = cryptoToProto(data)
+			results[i].NotFound = false
+		}
+	}
+
+	return results
+}
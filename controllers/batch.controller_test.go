@@ -0,0 +1,22 @@
+package controllers
+
+import "testing"
+
+func TestUncut(t *testing.T) {
+	// three removals on a crypto with a single like: the clamp cut two
+	cut := int64(2)
+	if got := uncut(-1, &cut); got != 0 {
+		t.Fatalf("first flagged removal kept %d, want 0", got)
+	}
+	if got := uncut(-2, &cut); got != -1 {
+		t.Fatalf("second flagged removal kept %d, want -1", got)
+	}
+	if got := uncut(-1, &cut); got != -1 || cut != 0 {
+		t.Fatalf("removal after the cut kept %d with %d left, want -1 and 0", got, cut)
+	}
+
+	cut = 5
+	if got := uncut(1, &cut); got != 1 || cut != 5 {
+		t.Fatalf("an addition came out as %d and used the cut", got)
+	}
+}
@@ -0,0 +1,87 @@
+package controllers
+
+import (
+	"api/app/pb"
+	"api/apperrors"
+	"api/cache"
+	"api/models"
+	"context"
+
+	mongobson "go.mongodb.org/mongo-driver/bson"
+	bson "go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type cryptoList struct {
+	Items []models.CryptoItem `bson:"items"`
+}
+
+// cachedCrypto returns the non-deleted crypto with objectId, reading through
+// the cache.
+func (s *CryptoServiceServer) cachedCrypto(ctx context.Context, objectId bson.ObjectID) (models.CryptoItem, error) {
+	var data models.CryptoItem
+	raw, err := s.Cache.Fetch(ctx, cache.CryptoKey(objectId.Hex()), func(ctx context.Context) ([]byte, error) {
+		if err := s.Db.FindOne(ctx, notDeleted(bson.M{"_id": objectId})).Decode(&data); err != nil {
+			return nil, err
+		}
+		return mongobson.Marshal(data)
+	})
+	if err != nil {
+		return data, err
+	}
+
+	err = mongobson.Unmarshal(raw, &data)
+	return data, err
+}
+
+// cachedList returns the first StreamMaxResults+1 non-deleted cryptos in
+// descending field order, reading through the cache, so callers can tell
+// whether the list was truncated.
+func (s *CryptoServiceServer) cachedList(ctx context.Context, field string) ([]models.CryptoItem, error) {
+	raw, err := s.Cache.Fetch(ctx, cache.ListKey(field), func(ctx context.Context) ([]byte, error) {
+		opts := options.Find().SetSort(bson.M{field: -1}).SetLimit(s.StreamMaxResults + 1)
+		cursor, err := s.Db.Find(ctx, notDeleted(bson.M{}), opts)
+		if err != nil {
+			return nil, err
+		}
+
+		var list cryptoList
+		if err := cursor.All(ctx, &list.Items); err != nil {
+			return nil, err
+		}
+		return mongobson.Marshal(list)
+	})
+	if err != nil {
+		return nil, apperrors.FromDB(err, "crypto", "")
+	}
+
+	var list cryptoList
+	if err := mongobson.Unmarshal(raw, &list); err != nil {
+		return nil, apperrors.FromDB(err, "crypto", "")
+	}
+
+	return list.Items, nil
+}
+
+func (s *CryptoServiceServer) GetCacheStats(ctx context.Context, req *pb.GetCacheStatsRequest) (*pb.GetCacheStatsResponse, error) {
+	if s.Cache == nil {
+		return &pb.GetCacheStatsResponse{}, nil
+	}
+
+	stats := s.Cache.Stats()
+	response := &pb.GetCacheStatsResponse{
+		Enabled:       true,
+		Hits:          stats.Hits,
+		Misses:        stats.Misses,
+		SharedLoads:   stats.SharedLoads,
+		Errors:        stats.Errors,
+		Invalidations: stats.Invalidations,
+		Evictions:     stats.Evictions,
+		Entries:       stats.Entries,
+	}
+	if lookups := stats.Hits + stats.Misses; lookups > 0 {
+		response.HitRatio = float64(stats.Hits) / float64(lookups)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,30 @@
+package controllers
+
+import (
+	"api/app/pb"
+	"api/apperrors"
+	"context"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *CryptoServiceServer) GetVoteChallenge(ctx context.Context, req *pb.GetVoteChallengeRequest) (*pb.GetVoteChallengeResponse, error) {
+	if s.Challenges == nil {
+		return &pb.GetVoteChallengeResponse{}, nil
+	}
+
+	challenge, err := s.Challenges.Issue()
+	if err != nil {
+		log.Printf("Could not issue a vote challenge: %v", err)
+		return nil, apperrors.New(codes.Internal, apperrors.ReasonInternal, "Internal error")
+	}
+
+	return &pb.GetVoteChallengeResponse{
+		Required:   true,
+		Challenge:  challenge.Token,
+		Difficulty: int32(challenge.Difficulty),
+		ExpiresAt:  timestamppb.New(challenge.ExpiresAt),
+	}, nil
+}
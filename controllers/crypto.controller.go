@@ -2,109 +2,405 @@ package controllers
 
 import (
 	"api/app/pb"
+	"api/apperrors"
+	"api/cache"
+	"api/challenge"
+	"api/events"
+	"api/fraud"
 	"api/models"
+	"api/outbox"
+	"api/ranking"
+	"api/suggest"
+	"api/trending"
+	"api/utils"
+	"api/votebuffer"
 	"context"
-	"fmt"
+	"errors"
+	"log"
+	"regexp"
 	"strings"
 	"time"
 
+	mongobson "go.mongodb.org/mongo-driver/bson"
 	bson "go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 type CryptoServiceServer struct {
-	Db  *mongo.Collection
-	Ctx context.Context
+	Db               *mongo.Collection
+	StreamTimeout    time.Duration
+	StreamMaxResults int64
+	ImportBatchSize  int
+	Events           *events.Bus
+	// Changes carries the events of every instance when the change stream
+	// feed runs, and is Events otherwise.
+	Changes        *events.Bus
+	Suggestions    *suggest.Index
+	Snapshots      *mongo.Collection
+	SnapshotBucket time.Duration
+	VoteEvents     *mongo.Collection
+	Trending       *trending.Ranker
+	Outbox         *outbox.Store
+	// Transactions is set when the deployment is a replica set or sharded
+	// cluster, which all-or-nothing batches need.
+	Transactions bool
+	Cache        *cache.Cache
+	// Votes buffers votes in memory when set; VoteNode names this instance
+	// in the flush markers of the cryptos.
+	Votes    *votebuffer.Buffer
+	VoteNode string
+	// WalletVotes records the vote of each wallet for CastSignedVote, and
+	// VoteNonces the nonces wallets signed within SignedVoteMaxAge.
+	WalletVotes      *mongo.Collection
+	VoteNonces       *mongo.Collection
+	SignedVoteMaxAge time.Duration
+	// Tallies holds the published tallies of the wallet votes, TallyLeaves
+	// the votes counted in them and TallyNodes the hashes of their trees.
+	Tallies     *mongo.Collection
+	TallyLeaves *mongo.Collection
+	TallyNodes  *mongo.Collection
+	// Fraud scores every vote when set, and FraudCases keeps those it
+	// finds suspicious. Behind TrustedProxies proxies the voter's address
+	// is taken from x-forwarded-for.
+	Fraud          *fraud.Detector
+	FraudCases     *fraud.Store
+	TrustedProxies int
+	// Challenges issues the proof of work anonymous votes need when set.
+	Challenges *challenge.Issuer
 	pb.UnimplementedCryptoServiceServer
 }
 
-func (s *CryptoServiceServer) CreateCrypto(ctx context.Context, req *pb.CreateCryptoRequest) (*pb.CreateCryptoResponse, error) {
-	data := models.CryptoItem{
+func cryptoToProto(data models.CryptoItem) *pb.Crypto {
+	return &pb.Crypto{
+		Id:          data.Id.Hex(),
+		Name:        data.Name,
+		Description: data.Description,
+		Likes:       data.Likes,
+		Dislikes:    data.Dislikes,
+		Symbol:      data.Symbol,
+		Slug:        data.Slug,
+	}
+}
+
+// streamCryptos runs a query bound to the stream's context, capped by
+// StreamTimeout and StreamMaxResults, and hands each document to send.
+// When the cap truncates the results the "x-results-truncated" trailer is set.
+func (s *CryptoServiceServer) streamCryptos(stream grpc.ServerStream, filter, sort bson.M, send func(models.CryptoItem) error) error {
+	ctx := stream.Context()
+	if s.StreamTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.StreamTimeout)
+		defer cancel()
+	}
+
+	opts := options.Find().SetSort(sort)
+	if s.StreamMaxResults > 0 {
+		opts.SetLimit(s.StreamMaxResults + 1)
+	}
+
+	cursor, err := s.Db.Find(ctx, filter, opts)
+	if err != nil {
+		return apperrors.FromDB(err, "crypto", "")
+	}
+
+	defer cursor.Close(context.Background())
+
+	var sent int64
+	for cursor.Next(ctx) {
+		if s.StreamMaxResults > 0 && sent == s.StreamMaxResults {
+			stream.SetTrailer(metadata.Pairs("x-results-truncated", "true"))
+			return nil
+		}
+
+		var data models.CryptoItem
+		if err := cursor.Decode(&data); err != nil {
+			return apperrors.FromDB(err, "crypto", "")
+		}
+
+		if err := send(data); err != nil {
+			return err
+		}
+		sent++
+	}
+
+	if err := cursor.Err(); err != nil {
+		return apperrors.FromDB(err, "crypto", "")
+	}
+
+	return nil
+}
+
+// commit runs write and stores the events it returns in the outbox within a
+// single transaction, then publishes them in-process. Without an outbox the
+// write runs on its own, as transactions need a replica set. Errors returned
+// by write are passed through unchanged.
+func (s *CryptoServiceServer) commit(ctx context.Context, write func(ctx context.Context) ([]events.Event, error)) error {
+	return s.commitIn(ctx, s.Outbox != nil, write)
+}
+
+// commitIn is commit, running write in a transaction when transaction is set
+// even without an outbox.
+func (s *CryptoServiceServer) commitIn(ctx context.Context, transaction bool, write func(ctx context.Context) ([]events.Event, error)) error {
+	var pending []events.Event
+	var writeFailed bool
+	run := func(ctx context.Context) error {
+		var err error
+		pending, err = write(ctx)
+		if writeFailed = err != nil; writeFailed {
+			return err
+		}
+		return s.Outbox.Enqueue(ctx, pending...)
+	}
+
+	var err error
+	if !transaction {
+		err = run(ctx)
+	} else {
+		err = s.Db.Database().Client().UseSession(ctx, func(sc mongo.SessionContext) error {
+			_, err := sc.WithTransaction(sc, func(sc mongo.SessionContext) (interface{}, error) {
+				return nil, run(sc)
+			})
+			return err
+		})
+	}
+	if err != nil && !writeFailed {
+		return apperrors.FromDB(err, "crypto", "")
+	}
+	if err != nil {
+		return err
+	}
+
+	s.publish(ctx, pending...)
+	return nil
+}
+
+// publish drops the cache entries the events make stale, so the writer reads
+// its own write, and hands the events to the bus.
+func (s *CryptoServiceServer) publish(ctx context.Context, pending ...events.Event) {
+	s.Cache.Invalidate(ctx, pending...)
+	for _, event := range pending {
+		s.Events.Publish(event)
+	}
+}
+
+func cryptoEvent(eventType events.Type, data models.CryptoItem) events.Event {
+	return events.Event{Type: eventType, CryptoId: data.Id.Hex(), Crypto: data, OccurredAt: time.Now()}
+}
+
+// voteEvent records the change a vote made to a crypto and returns the event
+// describing it. The vote stands even if the record cannot be stored.
+func (s *CryptoServiceServer) voteEvent(ctx context.Context, before, after models.CryptoItem) events.Event {
+	event := cryptoEvent(events.Voted, after)
+	event.LikesDelta = after.Likes - before.Likes
+	event.DislikesDelta = after.Dislikes - before.Dislikes
+
+	if s.VoteEvents != nil && (event.LikesDelta != 0 || event.DislikesDelta != 0) {
+		vote := models.VoteEvent{CryptoId: after.Id, Likes: event.LikesDelta, Dislikes: event.DislikesDelta, At: event.OccurredAt}
+		if _, err := s.VoteEvents.InsertOne(ctx, vote); err != nil {
+			log.Printf("Could not record vote on %s: %v", after.Id.Hex(), err)
+		}
+	}
+
+	return event
+}
+
+// notDeleted narrows filter to cryptos that are not in the trash.
+func notDeleted(filter bson.M) bson.M {
+	filter["deletedAt"] = nil
+	return filter
+}
+
+// duplicateField returns the first field of the unique index a duplicate
+// key error collided with, as reported in its keyPattern.
+func duplicateField(err error) string {
+	var raws []mongobson.Raw
+	var writeErr mongo.WriteException
+	if errors.As(err, &writeErr) {
+		for _, e := range writeErr.WriteErrors {
+			raws = append(raws, e.Raw)
+		}
+	}
+	var bulkErr mongo.BulkWriteError
+	if errors.As(err, &bulkErr) {
+		raws = append(raws, bulkErr.Raw)
+	}
+	var singleErr mongo.WriteError
+	if errors.As(err, &singleErr) {
+		raws = append(raws, singleErr.Raw)
+	}
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) {
+		raws = append(raws, cmdErr.Raw)
+	}
+
+	for _, raw := range raws {
+		pattern, ok := raw.Lookup("keyPattern").DocumentOK()
+		if !ok {
+			continue
+		}
+		if keys, err := pattern.Elements(); err == nil && len(keys) > 0 {
+			return keys[0].Key()
+		}
+	}
+
+	return ""
+}
+
+// duplicateError reports the symbol or slug a write collided on. It runs
+// outside the transaction of the write, which the collision aborted.
+func (s *CryptoServiceServer) duplicateError(ctx context.Context, err error, symbol, slug string) error {
+	field, value := "symbol", symbol
+	if duplicateField(err) == "slug" {
+		field, value = "slug", slug
+	}
+
+	var holder models.CryptoItem
+	opts := options.FindOne().SetProjection(bson.M{"deletedAt": 1})
+	if err := s.Db.FindOne(ctx, bson.M{field: value}, opts).Decode(&holder); err != nil {
+		return apperrors.AlreadyExists("crypto", field, value)
+	}
+	return conflictError(field, value, holder)
+}
+
+// conflictError reports a symbol or slug held by another crypto; one in the
+// trash keeps it until it is restored or purged.
+func conflictError(field, value string, holder models.CryptoItem) error {
+	if holder.DeletedAt != nil {
+		return apperrors.InTrash("crypto", field, value, holder.Id.Hex())
+	}
+
+	return apperrors.AlreadyExists("crypto", field, value)
+}
+
+func newCryptoItem(req *pb.CreateCryptoRequest) (models.CryptoItem, error) {
+	symbol := utils.NormalizeSymbol(req.GetSymbol())
+	slug := utils.Slugify(req.GetSlug())
+	if slug == "" {
+		slug = utils.Slugify(req.GetName())
+	}
+	if slug == "" {
+		return models.CryptoItem{}, apperrors.InvalidArgument("slug", "could not be derived from the supplied name")
+	}
+
+	return models.CryptoItem{
 		Name:        strings.ToUpper(req.GetName()),
-		Description: strings.Title(req.GetDescription()),
+		Symbol:      symbol,
+		Slug:        slug,
+		Description: strings.TrimSpace(req.GetDescription()),
 		Likes:       0,
 		Dislikes:    0,
+		Scores:      ranking.Compute(0, 0, time.Now()),
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
-	}
+	}, nil
+}
 
-	result, err := s.Db.InsertOne(s.Ctx, data)
+func (s *CryptoServiceServer) CreateCrypto(ctx context.Context, req *pb.CreateCryptoRequest) (*pb.CreateCryptoResponse, error) {
+	data, err := newCryptoItem(req)
 	if err != nil {
-		return nil, status.Errorf(
-			codes.Internal,
-			fmt.Sprintf("Internal error: %v", err),
-		)
+		return nil, err
 	}
 
-	data.Id = result.InsertedID.(bson.ObjectID)
+	err = s.commit(ctx, func(ctx context.Context) ([]events.Event, error) {
+		result, err := s.Db.InsertOne(ctx, data)
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, err
+		}
+		if err != nil {
+			return nil, apperrors.FromDB(err, "crypto", data.Symbol)
+		}
+
+		data.Id = result.InsertedID.(bson.ObjectID)
+		return []events.Event{cryptoEvent(events.Created, data)}, nil
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, s.duplicateError(ctx, err, data.Symbol, data.Slug)
+	}
+	if err != nil {
+		return nil, err
+	}
 
 	return &pb.CreateCryptoResponse{
 		Success: true,
-		Crypto: &pb.Crypto{
-			Name:        data.Name,
-			Description: data.Description,
-			Likes:       data.Likes,
-			Dislikes:    data.Dislikes,
-		},
+		Crypto:  cryptoToProto(data),
 	}, nil
 }
 
+// rankingOf returns the sort field of a ranking strategy and the score it
+// holds for a crypto.
+func rankingOf(strategy pb.RankingStrategy) (string, func(models.CryptoItem) float64) {
+	switch strategy {
+	case pb.RankingStrategy_RATIO:
+		return ranking.RatioField, func(data models.CryptoItem) float64 { return data.Scores.Ratio }
+	case pb.RankingStrategy_WILSON:
+		return ranking.WilsonField, func(data models.CryptoItem) float64 { return data.Scores.Wilson }
+	case pb.RankingStrategy_BAYESIAN:
+		return ranking.BayesianField, func(data models.CryptoItem) float64 { return data.Scores.Bayesian }
+	case pb.RankingStrategy_HOT:
+		return ranking.HotField, func(data models.CryptoItem) float64 { return data.Scores.Hot }
+	case pb.RankingStrategy_CONTROVERSIAL:
+		return ranking.ControversyField, func(data models.CryptoItem) float64 { return data.Scores.Controversy }
+	}
+
+	return ranking.NetField, func(data models.CryptoItem) float64 { return float64(data.VoteRate) }
+}
+
 func (s *CryptoServiceServer) ListCryptos(req *pb.ListCryptosRequest, stream pb.CryptoService_ListCryptosServer) error {
-	cursor, err := s.Db.Find(s.Ctx, bson.M{}, options.Find().SetSort(bson.M{"voteRate": -1}))
-	if err != nil {
-		return status.Errorf(codes.Internal, fmt.Sprintf("Unknown internal error: %v", err))
+	field, score := rankingOf(req.GetRanking())
+	send := func(data models.CryptoItem) error {
+		data = s.withPending(data)
+		return stream.Send(&pb.ListCryptosResponse{
+			Crypto: cryptoToProto(data),
+			Score:  score(data),
+		})
 	}
 
-	defer cursor.Close(s.Ctx)
+	// an unbounded list is too large to cache
+	if s.Cache == nil || s.StreamMaxResults <= 0 {
+		return s.streamCryptos(stream, notDeleted(bson.M{}), bson.M{field: -1}, send)
+	}
 
-	var data models.CryptoItem
-	for cursor.Next(s.Ctx) {
-		err := cursor.Decode(&data)
-		if err != nil {
-			return status.Errorf(codes.Unavailable, fmt.Sprintf("Could not decode data: %v", err))
-		}
+	ctx := stream.Context()
+	if s.StreamTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.StreamTimeout)
+		defer cancel()
+	}
 
-		stream.Send(&pb.ListCryptosResponse{
-			Crypto: &pb.Crypto{
-				Id:          data.Id.Hex(),
-				Name:        data.Name,
-				Description: data.Description,
-				Likes:       data.Likes,
-				Dislikes:    data.Dislikes,
-			},
-		})
+	items, err := s.cachedList(ctx, field)
+	if err != nil {
+		return err
 	}
 
-	if err := cursor.Err(); err != nil {
-		return status.Errorf(codes.Internal, fmt.Sprintf("Unkown cursor error: %v", err))
+	for i, data := range items {
+		if int64(i) == s.StreamMaxResults {
+			stream.SetTrailer(metadata.Pairs("x-results-truncated", "true"))
+			return nil
+		}
+		if err := send(data); err != nil {
+			return err
+		}
 	}
+
 	return nil
 }
 
 func (s *CryptoServiceServer) ReadCrypto(ctx context.Context, req *pb.ReadCryptoRequest) (*pb.ReadCryptoResponse, error) {
 	objectId, err := bson.ObjectIDFromHex(req.GetId())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Could not convert to ObjectId: %v", err))
+		return nil, apperrors.InvalidArgument("id", "must be a valid ObjectId")
 	}
 
-	result := s.Db.FindOne(ctx, bson.M{"_id": objectId})
-
-	var data models.CryptoItem
-	if err := result.Decode(&data); err != nil {
-		return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Could not find crypto with Object Id %s: %v", req.GetId(), err))
+	data, err := s.cachedCrypto(ctx, objectId)
+	if err != nil {
+		return nil, apperrors.FromDB(err, "crypto", req.GetId())
 	}
 
 	response := &pb.ReadCryptoResponse{
-		Crypto: &pb.Crypto{
-			Id:          objectId.Hex(),
-			Name:        data.Name,
-			Description: data.Description,
-			Likes:       data.Likes,
-			Dislikes:    data.Dislikes,
-		},
+		Crypto: cryptoToProto(s.withPending(data)),
 	}
 
 	return response, nil
@@ -113,50 +409,71 @@ func (s *CryptoServiceServer) ReadCrypto(ctx context.Context, req *pb.ReadCrypto
 func (s *CryptoServiceServer) UpdateCrypto(ctx context.Context, req *pb.UpdateCryptoRequest) (*pb.UpdateCryptoResponse, error) {
 	objectId, err := bson.ObjectIDFromHex(req.GetId())
 	if err != nil {
-		return nil, status.Errorf(
-			codes.InvalidArgument,
-			fmt.Sprintf("Could not convert the supplied crypto id to a MongoDB ObjectId: %v", err),
-		)
+		return nil, apperrors.InvalidArgument("id", "must be a valid ObjectId")
 	}
 
 	update := bson.M{
 		"name":        strings.ToUpper(req.GetName()),
-		"description": strings.Title(req.GetDescription()),
+		"description": strings.TrimSpace(req.GetDescription()),
 		"updatedAt":   time.Now(),
 	}
-	filter := bson.M{"_id": objectId}
-
-	result := s.Db.FindOneAndUpdate(ctx, filter, bson.M{"$set": update}, options.FindOneAndUpdate().SetReturnDocument(1))
+	symbol := utils.NormalizeSymbol(req.GetSymbol())
+	if symbol != "" {
+		update["symbol"] = symbol
+	}
+	slug := utils.Slugify(req.GetSlug())
+	if slug != "" {
+		update["slug"] = slug
+	}
+	filter := notDeleted(bson.M{"_id": objectId})
 
 	var data models.CryptoItem
-	err = result.Decode(&data)
+	err = s.commit(ctx, func(ctx context.Context) ([]events.Event, error) {
+		result := s.Db.FindOneAndUpdate(ctx, filter, bson.M{"$set": update}, options.FindOneAndUpdate().SetReturnDocument(1))
+
+		err := result.Decode(&data)
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, err
+		}
+		if err != nil {
+			return nil, apperrors.FromDB(err, "crypto", req.GetId())
+		}
+
+		return []events.Event{cryptoEvent(events.Updated, data)}, nil
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, s.duplicateError(ctx, err, symbol, slug)
+	}
 	if err != nil {
-		return nil, status.Errorf(
-			codes.NotFound,
-			fmt.Sprintf("Could not find crypto with supplied ID: %v", err),
-		)
+		return nil, err
 	}
 
 	return &pb.UpdateCryptoResponse{
 		Success: true,
-		Crypto: &pb.Crypto{
-			Name:        data.Name,
-			Description: data.Description,
-			Likes:       data.Likes,
-			Dislikes:    data.Dislikes,
-		},
+		Crypto:  cryptoToProto(s.withPending(data)),
 	}, nil
 }
 
 func (s *CryptoServiceServer) DeleteCrypto(ctx context.Context, req *pb.DeleteCryptoRequest) (*pb.DeleteCryptoResponse, error) {
 	objectId, err := bson.ObjectIDFromHex(req.GetId())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Could not convert to ObjectId: %v", err))
+		return nil, apperrors.InvalidArgument("id", "must be a valid ObjectId")
 	}
 
-	_, err = s.Db.DeleteOne(ctx, bson.M{"_id": objectId})
+	update := bson.M{"$set": bson.M{"deletedAt": time.Now()}}
+	err = s.commit(ctx, func(ctx context.Context) ([]events.Event, error) {
+		result, err := s.Db.UpdateOne(ctx, notDeleted(bson.M{"_id": objectId}), update)
+		if err != nil {
+			return nil, apperrors.FromDB(err, "crypto", req.GetId())
+		}
+		if result.MatchedCount == 0 {
+			return nil, apperrors.NotFound("crypto", req.GetId())
+		}
+
+		return []events.Event{cryptoEvent(events.Deleted, models.CryptoItem{Id: objectId})}, nil
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Could not find/delete crypto with id %s: %v", req.GetId(), err))
+		return nil, err
 	}
 
 	return &pb.DeleteCryptoResponse{
@@ -165,242 +482,150 @@ func (s *CryptoServiceServer) DeleteCrypto(ctx context.Context, req *pb.DeleteCr
 }
 
 func (s *CryptoServiceServer) AddLike(ctx context.Context, req *pb.AddLikeRequest) (*pb.AddLikeResponse, error) {
-	objectId, err := bson.ObjectIDFromHex(req.GetId())
+	data, err := s.vote(ctx, req.GetId(), 1, 0)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Could not convert to ObjectId: %v", err))
-	}
-
-	var data models.CryptoItem
-	result := s.Db.FindOne(ctx, bson.M{"_id": objectId})
-	err = result.Decode(&data)
-	if err != nil {
-		return nil, status.Errorf(
-			codes.NotFound,
-			fmt.Sprintf("Could not find crypto with supplied ID: %v", err),
-		)
-	}
-
-	update := bson.M{
-		"likes":     data.Likes + 1,
-		"voteRate":  (data.Likes + 1) - data.Dislikes,
-		"updatedAt": time.Now(),
-	}
-	filter := bson.M{"_id": objectId}
-
-	result = s.Db.FindOneAndUpdate(ctx, filter, bson.M{"$set": update}, options.FindOneAndUpdate().SetReturnDocument(1))
-	err = result.Decode(&data)
-	if err != nil {
-		return nil, status.Errorf(
-			codes.NotFound,
-			fmt.Sprintf("Could not find crypto with supplied ID: %v", err),
-		)
+		return nil, err
 	}
 
 	return &pb.AddLikeResponse{
-		Crypto: &pb.Crypto{
-			Id:       data.Id.Hex(),
-			Name:     data.Name,
-			Likes:    data.Likes,
-			Dislikes: data.Dislikes,
-		},
+		Crypto: cryptoToProto(data),
 	}, nil
 }
 
 func (s *CryptoServiceServer) RemoveLike(ctx context.Context, req *pb.RemoveLikeRequest) (*pb.RemoveLikeResponse, error) {
-	objectId, err := bson.ObjectIDFromHex(req.GetId())
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Could not convert to ObjectId: %v", err))
-	}
-
-	var data models.CryptoItem
-	result := s.Db.FindOne(ctx, bson.M{"_id": objectId})
-	err = result.Decode(&data)
-	if err != nil {
-		return nil, status.Errorf(
-			codes.NotFound,
-			fmt.Sprintf("Could not find crypto with supplied ID: %v", err),
-		)
-	}
-
-	var likes int64
-	if data.Likes-1 < 0 {
-		likes = 0
-	} else {
-		likes = data.Likes - 1
-	}
-
-	update := bson.M{
-		"likes":     likes,
-		"voteRate":  likes - data.Dislikes,
-		"updatedAt": time.Now(),
-	}
-	filter := bson.M{"_id": objectId}
-
-	result = s.Db.FindOneAndUpdate(ctx, filter, bson.M{"$set": update}, options.FindOneAndUpdate().SetReturnDocument(1))
-	err = result.Decode(&data)
+	data, err := s.vote(ctx, req.GetId(), -1, 0)
 	if err != nil {
-		return nil, status.Errorf(
-			codes.NotFound,
-			fmt.Sprintf("Could not find crypto with supplied ID: %v", err),
-		)
+		return nil, err
 	}
 
 	return &pb.RemoveLikeResponse{
-		Crypto: &pb.Crypto{
-			Id:       data.Id.Hex(),
-			Name:     data.Name,
-			Likes:    data.Likes,
-			Dislikes: data.Dislikes,
-		},
+		Crypto: cryptoToProto(data),
 	}, nil
 }
 
 func (s *CryptoServiceServer) AddDislike(ctx context.Context, req *pb.AddDislikeRequest) (*pb.AddDislikeResponse, error) {
-	objectId, err := bson.ObjectIDFromHex(req.GetId())
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Could not convert to ObjectId: %v", err))
-	}
-
-	var data models.CryptoItem
-	result := s.Db.FindOne(ctx, bson.M{"_id": objectId})
-	err = result.Decode(&data)
+	data, err := s.vote(ctx, req.GetId(), 0, 1)
 	if err != nil {
-		return nil, status.Errorf(
-			codes.NotFound,
-			fmt.Sprintf("Could not find crypto with supplied ID: %v", err),
-		)
-	}
-
-	update := bson.M{
-		"dislikes":  data.Dislikes + 1,
-		"voteRate":  data.Likes - (data.Dislikes + 1),
-		"updatedAt": time.Now(),
-	}
-	filter := bson.M{"_id": objectId}
-
-	result = s.Db.FindOneAndUpdate(ctx, filter, bson.M{"$set": update}, options.FindOneAndUpdate().SetReturnDocument(1))
-	err = result.Decode(&data)
-	if err != nil {
-		return nil, status.Errorf(
-			codes.NotFound,
-			fmt.Sprintf("Could not find crypto with supplied ID: %v", err),
-		)
+		return nil, err
 	}
 
 	return &pb.AddDislikeResponse{
-		Crypto: &pb.Crypto{
-			Id:       data.Id.Hex(),
-			Name:     data.Name,
-			Likes:    data.Likes,
-			Dislikes: data.Dislikes,
-		},
+		Crypto: cryptoToProto(data),
 	}, nil
 }
 
 func (s *CryptoServiceServer) RemoveDislike(ctx context.Context, req *pb.RemoveDislikeRequest) (*pb.RemoveDislikeResponse, error) {
-	objectId, err := bson.ObjectIDFromHex(req.GetId())
+	data, err := s.vote(ctx, req.GetId(), 0, -1)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Could not convert to ObjectId: %v", err))
+		return nil, err
 	}
 
-	var data models.CryptoItem
-	result := s.Db.FindOne(ctx, bson.M{"_id": objectId})
-	err = result.Decode(&data)
+	return &pb.RemoveDislikeResponse{
+		Crypto: cryptoToProto(data),
+	}, nil
+}
+
+// vote casts an anonymous vote on a crypto: the fraud detector screens it,
+// then it is buffered or written at once.
+func (s *CryptoServiceServer) vote(ctx context.Context, id string, likes, dislikes int64) (models.CryptoItem, error) {
+	objectId, err := bson.ObjectIDFromHex(id)
 	if err != nil {
-		return nil, status.Errorf(
-			codes.NotFound,
-			fmt.Sprintf("Could not find crypto with supplied ID: %v", err),
-		)
+		return models.CryptoItem{}, apperrors.InvalidArgument("id", "must be a valid ObjectId")
 	}
 
-	var dislikes int64
-	if data.Dislikes-1 < 0 {
-		dislikes = 0
-	} else {
-		dislikes = data.Dislikes - 1
+	vote, verdict := s.screenVote(ctx, objectId, likes, dislikes, "", time.Time{})
+	if !verdict.Counted() {
+		return s.holdVote(ctx, objectId, vote, verdict)
 	}
 
-	update := bson.M{
-		"dislikes":  dislikes,
-		"voteRate":  data.Likes - dislikes,
-		"updatedAt": time.Now(),
+	if s.Votes != nil {
+		data, err := s.cachedCrypto(ctx, objectId)
+		if err != nil {
+			return data, apperrors.FromDB(err, "crypto", id)
+		}
+		data, applied, err := s.bufferVote(data, likes, dislikes)
+		if err != nil {
+			return data, err
+		}
+		s.flagVote(ctx, objectId, vote, verdict, applied.Likes, applied.Dislikes)
+		return data, nil
 	}
-	filter := bson.M{"_id": objectId}
 
-	result = s.Db.FindOneAndUpdate(ctx, filter, bson.M{"$set": update}, options.FindOneAndUpdate().SetReturnDocument(1))
-	err = result.Decode(&data)
+	var data, before models.CryptoItem
+	err = s.commit(ctx, func(ctx context.Context) ([]events.Event, error) {
+		if before, data, err = s.updateVote(ctx, objectId, likes, dislikes); err != nil {
+			return nil, apperrors.FromDB(err, "crypto", id)
+		}
+
+		return []events.Event{s.voteEvent(ctx, before, data)}, nil
+	})
 	if err != nil {
-		return nil, status.Errorf(
-			codes.NotFound,
-			fmt.Sprintf("Could not find crypto with supplied ID: %v", err),
-		)
+		return data, err
 	}
 
-	return &pb.RemoveDislikeResponse{
-		Crypto: &pb.Crypto{
-			Id:       data.Id.Hex(),
-			Name:     data.Name,
-			Likes:    data.Likes,
-			Dislikes: data.Dislikes,
-		},
-	}, nil
+	s.flagVote(ctx, objectId, vote, verdict, data.Likes-before.Likes, data.Dislikes-before.Dislikes)
+	return data, nil
 }
 
 func (s *CryptoServiceServer) CountVotes(ctx context.Context, req *pb.CountVotesRequest) (*pb.CountVotesResponse, error) {
 	objectId, err := bson.ObjectIDFromHex(req.GetId())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Could not convert to ObjectId: %v", err))
+		return nil, apperrors.InvalidArgument("id", "must be a valid ObjectId")
 	}
 
 	var total int64
 
 	var data models.CryptoItem
-	result := s.Db.FindOne(ctx, bson.M{"_id": objectId})
+	result := s.Db.FindOne(ctx, notDeleted(bson.M{"_id": objectId}))
 	err = result.Decode(&data)
 	if err != nil {
-		return nil, status.Errorf(
-			codes.NotFound,
-			fmt.Sprintf("Could not find crypto with supplied ID: %v", err),
-		)
+		return nil, apperrors.FromDB(err, "crypto", req.GetId())
 	}
 
+	data = s.withPending(data)
 	total = data.Likes + data.Dislikes
 
+	tally, err := s.latestTally(ctx, objectId)
+	if err != nil {
+		return nil, apperrors.FromDB(err, "vote tally", req.GetId())
+	}
+
 	return &pb.CountVotesResponse{
 		Name:  data.Name,
 		Total: total,
+		Tally: tally,
 	}, nil
 }
 
 func (s *CryptoServiceServer) FilterByName(req *pb.FilterByNameRequest, stream pb.CryptoService_FilterByNameServer) error {
-	cursor, err := s.Db.Find(s.Ctx, bson.M{"name": bson.Regex{Pattern: req.GetName(), Options: "i"}}, options.Find().SetSort(bson.M{"likes": -1}))
-	if err != nil {
-		return status.Errorf(codes.Internal, fmt.Sprintf("Unknown internal error: %v", err))
-	}
+	filter := notDeleted(bson.M{"name": bson.Regex{Pattern: regexp.QuoteMeta(req.GetName()), Options: "i"}})
+	return s.streamCryptos(stream, filter, bson.M{"likes": -1}, func(data models.CryptoItem) error {
+		return stream.Send(cryptoToProto(s.withPending(data)))
+	})
+}
 
-	defer cursor.Close(s.Ctx)
+func (s *CryptoServiceServer) GetCryptoBySymbol(ctx context.Context, req *pb.GetCryptoBySymbolRequest) (*pb.GetCryptoBySymbolResponse, error) {
+	symbol := utils.NormalizeSymbol(req.GetSymbol())
 
 	var data models.CryptoItem
-	for cursor.Next(s.Ctx) {
-		err := cursor.Decode(&data)
-		if err != nil {
-			return status.Errorf(codes.Unavailable, fmt.Sprintf("Could not decode data: %v", err))
-		}
-
-		stream.Send(
-			&pb.Crypto{
-				Id:          data.Id.Hex(),
-				Name:        data.Name,
-				Description: data.Description,
-				Likes:       data.Likes,
-				Dislikes:    data.Dislikes,
-			},
-		)
+	if err := s.Db.FindOne(ctx, notDeleted(bson.M{"symbol": symbol})).Decode(&data); err != nil {
+		return nil, apperrors.FromDB(err, "crypto", symbol)
 	}
 
-	if err := cursor.Err(); err != nil {
-		return status.Errorf(codes.Internal, fmt.Sprintf("Unkown cursor error: %v", err))
+	return &pb.GetCryptoBySymbolResponse{
+		Crypto: cryptoToProto(s.withPending(data)),
+	}, nil
+}
+
+func (s *CryptoServiceServer) GetCryptoBySlug(ctx context.Context, req *pb.GetCryptoBySlugRequest) (*pb.GetCryptoBySlugResponse, error) {
+	slug := strings.ToLower(strings.TrimSpace(req.GetSlug()))
+
+	var data models.CryptoItem
+	if err := s.Db.FindOne(ctx, notDeleted(bson.M{"slug": slug})).Decode(&data); err != nil {
+		return nil, apperrors.FromDB(err, "crypto", slug)
 	}
 
-	return nil
+	return &pb.GetCryptoBySlugResponse{
+		Crypto: cryptoToProto(s.withPending(data)),
+	}, nil
 }
@@ -0,0 +1,58 @@
+package controllers
+
+import (
+	"api/app/pb"
+	"api/apperrors"
+	"api/catalog"
+	"bytes"
+	"strings"
+)
+
+const exportChunkSize = 64 * 1024
+
+// chunkSender buffers serialized output and streams it in exportChunkSize pieces.
+type chunkSender struct {
+	buf    bytes.Buffer
+	stream pb.CryptoService_ExportCryptosServer
+}
+
+func (c *chunkSender) Write(p []byte) (int, error) {
+	c.buf.Write(p)
+	for c.buf.Len() >= exportChunkSize {
+		if err := c.flush(exportChunkSize); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(p), nil
+}
+
+func (c *chunkSender) flush(n int) error {
+	if n > c.buf.Len() {
+		n = c.buf.Len()
+	}
+	if n == 0 {
+		return nil
+	}
+
+	chunk := make([]byte, n)
+	c.buf.Read(chunk)
+	return c.stream.Send(&pb.ExportCryptosResponse{Data: chunk})
+}
+
+func (s *CryptoServiceServer) ExportCryptos(req *pb.ExportCryptosRequest, stream pb.CryptoService_ExportCryptosServer) error {
+	sender := &chunkSender{stream: stream}
+	writer, err := catalog.NewWriter(strings.ToLower(req.GetFormat().String()), sender)
+	if err != nil {
+		return apperrors.InvalidArgument("format", "is not supported")
+	}
+
+	if _, err := catalog.Export(stream.Context(), s.Db, writer, req.GetIncludeDeleted()); err != nil {
+		return apperrors.FromDB(err, "crypto", "")
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	return sender.flush(sender.buf.Len())
+}
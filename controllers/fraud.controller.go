@@ -0,0 +1,257 @@
+package controllers
+
+import (
+	"api/app/pb"
+	"api/apperrors"
+	"api/events"
+	"api/fraud"
+	"api/models"
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	bson "go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultFraudCaseLimit = 50
+	maxFraudCaseLimit     = 500
+)
+
+var fraudActions = map[string]pb.FraudAction{
+	string(fraud.Flag):       pb.FraudAction_FLAG,
+	string(fraud.Discount):   pb.FraudAction_DISCOUNT,
+	string(fraud.Quarantine): pb.FraudAction_QUARANTINE,
+}
+
+var fraudCaseStatuses = map[pb.FraudCaseStatus]string{
+	pb.FraudCaseStatus_OPEN:     models.FraudCaseOpen,
+	pb.FraudCaseStatus_APPROVED: models.FraudCaseApproved,
+	pb.FraudCaseStatus_REJECTED: models.FraudCaseRejected,
+}
+
+func fraudCaseToProto(record models.FraudCase) *pb.FraudCase {
+	result := &pb.FraudCase{
+		Id:            record.Id.Hex(),
+		CryptoId:      record.CryptoId.Hex(),
+		LikesDelta:    record.Likes,
+		DislikesDelta: record.Dislikes,
+		Ip:            record.Ip,
+		Network:       record.Network,
+		Voter:         record.Voter,
+		Score:         record.Score,
+		Reasons:       record.Reasons,
+		Action:        fraudActions[record.Action],
+		Counted:       record.Counted,
+		CreatedAt:     timestamppb.New(record.CreatedAt),
+	}
+	for status, name := range fraudCaseStatuses {
+		if name == record.Status {
+			result.Status = status
+		}
+	}
+	if record.QuarantinedUntil != nil {
+		result.QuarantinedUntil = timestamppb.New(*record.QuarantinedUntil)
+	}
+	if record.ReviewedAt != nil {
+		result.ReviewedAt = timestamppb.New(*record.ReviewedAt)
+	}
+
+	return result
+}
+
+// screenVote scores a vote with the fraud detector. Without one every vote
+// counts.
+func (s *CryptoServiceServer) screenVote(ctx context.Context, objectId bson.ObjectID, likes, dislikes int64, voter string, voterSince time.Time) (fraud.Vote, fraud.Verdict) {
+	vote := fraud.Vote{CryptoId: objectId.Hex(), Likes: likes, Dislikes: dislikes, Voter: voter, VoterSince: voterSince, At: time.Now()}
+	if s.Fraud == nil {
+		return vote, fraud.Verdict{}
+	}
+
+	vote.Ip = fraud.ClientIp(ctx, s.TrustedProxies)
+	return vote, s.Fraud.Score(&vote)
+}
+
+// holdVote records a vote the fraud detector did not count and returns the
+// crypto as it is, so the voter cannot tell the vote was held.
+func (s *CryptoServiceServer) holdVote(ctx context.Context, objectId bson.ObjectID, vote fraud.Vote, verdict fraud.Verdict) (models.CryptoItem, error) {
+	data, err := s.cachedCrypto(ctx, objectId)
+	if err != nil {
+		return data, apperrors.FromDB(err, "crypto", objectId.Hex())
+	}
+	if err := s.FraudCases.Open(ctx, objectId, vote, verdict, vote.Likes, vote.Dislikes); err != nil {
+		return data, apperrors.FromDB(err, "fraud case", "")
+	}
+
+	return s.withPending(data), nil
+}
+
+// flagVote records a counted vote the fraud detector flagged, with the
+// change it made to the counts, so rejecting it takes back no more than
+// that. The vote stands even if the case cannot be stored.
+func (s *CryptoServiceServer) flagVote(ctx context.Context, objectId bson.ObjectID, vote fraud.Vote, verdict fraud.Verdict, likes, dislikes int64) {
+	if verdict.Action != fraud.Flag {
+		return
+	}
+	if err := s.FraudCases.Open(ctx, objectId, vote, verdict, likes, dislikes); err != nil {
+		log.Printf("Could not record flagged vote on %s: %v", objectId.Hex(), err)
+	}
+}
+
+// walletSince returns when a wallet first voted, or now for a new wallet.
+func (s *CryptoServiceServer) walletSince(ctx context.Context, address string) (time.Time, error) {
+	var first models.WalletVote
+	opts := options.FindOne().SetSort(bson.M{"createdAt": 1}).SetProjection(bson.M{"createdAt": 1})
+	err := s.WalletVotes.FindOne(ctx, bson.M{"address": address}, opts).Decode(&first)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return time.Now(), nil
+	}
+
+	return first.CreatedAt, err
+}
+
+// applyVote changes the counts of a crypto by a vote decided on after it was
+// cast. Database errors are returned unchanged.
+func (s *CryptoServiceServer) applyVote(ctx context.Context, objectId bson.ObjectID, likes, dislikes int64) (models.CryptoItem, error) {
+	if likes == 0 && dislikes == 0 {
+		data, err := s.cachedCrypto(ctx, objectId)
+		return s.withPending(data), err
+	}
+	if s.Votes != nil {
+		data, err := s.cachedCrypto(ctx, objectId)
+		if err != nil {
+			return data, err
+		}
+		data, _, err = s.bufferVote(data, likes, dislikes)
+		return data, err
+	}
+
+	var data models.CryptoItem
+	err := s.commit(ctx, func(ctx context.Context) ([]events.Event, error) {
+		before, after, err := s.updateVote(ctx, objectId, likes, dislikes)
+		if err != nil {
+			return nil, err
+		}
+		data = after
+
+		return []events.Event{s.voteEvent(ctx, before, data)}, nil
+	})
+
+	return data, err
+}
+
+func (s *CryptoServiceServer) ListFraudCases(ctx context.Context, req *pb.ListFraudCasesRequest) (*pb.ListFraudCasesResponse, error) {
+	if s.FraudCases == nil {
+		return &pb.ListFraudCasesResponse{}, nil
+	}
+
+	filter := bson.M{}
+	if req.GetCryptoId() != "" {
+		objectId, err := bson.ObjectIDFromHex(req.GetCryptoId())
+		if err != nil {
+			return nil, apperrors.InvalidArgument("crypto_id", "must be a valid ObjectId")
+		}
+		filter["cryptoId"] = objectId
+	}
+	if status, ok := fraudCaseStatuses[req.GetStatus()]; ok {
+		filter["status"] = status
+	}
+
+	limit := int64(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultFraudCaseLimit
+	}
+	if limit > maxFraudCaseLimit {
+		limit = maxFraudCaseLimit
+	}
+
+	cursor, err := s.FraudCases.Cases.Find(ctx, filter, options.Find().SetSort(bson.M{"createdAt": -1}).SetLimit(limit))
+	if err != nil {
+		return nil, apperrors.FromDB(err, "fraud case", "")
+	}
+
+	defer cursor.Close(ctx)
+
+	response := &pb.ListFraudCasesResponse{}
+	for cursor.Next(ctx) {
+		var record models.FraudCase
+		if err := cursor.Decode(&record); err != nil {
+			return nil, apperrors.FromDB(err, "fraud case", "")
+		}
+		response.Cases = append(response.Cases, fraudCaseToProto(record))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, apperrors.FromDB(err, "fraud case", "")
+	}
+
+	return response, nil
+}
+
+// ReviewFraudCase approves or rejects an open case. Approving counts a vote
+// that was held and lifts the quarantine it started; rejecting takes a
+// counted vote back, and a signed vote back from its wallet. The case is
+// reopened if its vote cannot be changed.
+func (s *CryptoServiceServer) ReviewFraudCase(ctx context.Context, req *pb.ReviewFraudCaseRequest) (*pb.ReviewFraudCaseResponse, error) {
+	objectId, err := bson.ObjectIDFromHex(req.GetId())
+	if err != nil {
+		return nil, apperrors.InvalidArgument("id", "must be a valid ObjectId")
+	}
+	status := fraudCaseStatuses[req.GetStatus()]
+	if status != models.FraudCaseApproved && status != models.FraudCaseRejected {
+		return nil, apperrors.InvalidArgument("status", "must be APPROVED or REJECTED")
+	}
+	if s.FraudCases == nil {
+		return nil, apperrors.NotFound("open fraud case", req.GetId())
+	}
+
+	record, err := s.FraudCases.Review(ctx, objectId, status)
+	if err != nil {
+		return nil, apperrors.FromDB(err, "open fraud case", req.GetId())
+	}
+
+	var likes, dislikes int64
+	switch {
+	case status == models.FraudCaseApproved && !record.Counted:
+		likes, dislikes = record.Likes, record.Dislikes
+	case status == models.FraudCaseRejected && record.Counted:
+		likes, dislikes = -record.Likes, -record.Dislikes
+	}
+
+	if record.Voter != "" {
+		settle := s.approveWalletVote
+		if status == models.FraudCaseRejected {
+			settle = s.revertWalletVote
+		}
+		if err := settle(ctx, record); err != nil {
+			if err := s.FraudCases.Reopen(ctx, objectId); err != nil {
+				log.Printf("Could not reopen fraud case %s: %v", req.GetId(), err)
+			}
+			return nil, apperrors.FromDB(err, "wallet vote", record.Voter)
+		}
+	}
+
+	response := &pb.ReviewFraudCaseResponse{}
+	data, err := s.applyVote(ctx, record.CryptoId, likes, dislikes)
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		// the crypto is gone, so there is no vote left to change
+	case err != nil:
+		if err := s.FraudCases.Reopen(ctx, objectId); err != nil {
+			log.Printf("Could not reopen fraud case %s: %v", req.GetId(), err)
+		}
+		return nil, apperrors.FromDB(err, "crypto", record.CryptoId.Hex())
+	default:
+		response.Crypto = cryptoToProto(data)
+	}
+
+	if status == models.FraudCaseApproved && record.QuarantinedUntil != nil && s.Fraud != nil {
+		s.Fraud.Release(record.Sources...)
+	}
+
+	response.Case = fraudCaseToProto(record)
+	return response, nil
+}
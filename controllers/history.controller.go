@@ -0,0 +1,148 @@
+package controllers
+
+import (
+	"api/app/pb"
+	"api/apperrors"
+	"context"
+	"time"
+
+	bson "go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultHistoryRange     = 7 * 24 * time.Hour
+	defaultHistoryMaxPoints = 200
+	maxHistoryMaxPoints     = 1000
+)
+
+type historyPoint struct {
+	Bucket   time.Time `bson:"_id"`
+	Likes    int64     `bson:"likes"`
+	Dislikes int64     `bson:"dislikes"`
+	VoteRate int64     `bson:"voteRate"`
+}
+
+func resolutionOf(resolution pb.HistoryResolution) time.Duration {
+	switch resolution {
+	case pb.HistoryResolution_MINUTE:
+		return time.Minute
+	case pb.HistoryResolution_HOUR:
+		return time.Hour
+	case pb.HistoryResolution_DAY:
+		return 24 * time.Hour
+	case pb.HistoryResolution_WEEK:
+		return 7 * 24 * time.Hour
+	}
+
+	return 0
+}
+
+// historyStep picks the point width and the aligned start of the first
+// point: never finer than the snapshot bucket, and coarsened by whole buckets
+// until the range fits in maxPoints.
+func historyStep(bucket, resolution time.Duration, from, to time.Time, maxPoints int) (time.Duration, time.Time) {
+	step := bucket
+	if resolution > step {
+		step = resolution
+	}
+	if minStep := to.Sub(from) / time.Duration(maxPoints); step < minStep {
+		step = (minStep + bucket - 1) / bucket * bucket
+	}
+
+	for {
+		origin := from.UTC().Truncate(step)
+		if (to.Sub(origin)+step-1)/step <= time.Duration(maxPoints) {
+			return step, origin
+		}
+		step += bucket
+	}
+}
+
+// historyPipeline keeps the last snapshot of each step-wide group, groups
+// being aligned on origin. Snapshots are gauges, so the last one wins.
+func historyPipeline(cryptoId bson.ObjectID, from, to, origin time.Time, step time.Duration) mongo.Pipeline {
+	group := bson.M{"$subtract": bson.A{
+		"$bucket",
+		bson.M{"$mod": bson.A{bson.M{"$subtract": bson.A{"$bucket", origin}}, step.Milliseconds()}},
+	}}
+
+	return mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"cryptoId": cryptoId, "bucket": bson.M{"$gte": from, "$lt": to}}}},
+		{{Key: "$sort", Value: bson.M{"bucket": 1}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":      group,
+			"likes":    bson.M{"$last": "$likes"},
+			"dislikes": bson.M{"$last": "$dislikes"},
+			"voteRate": bson.M{"$last": "$voteRate"},
+		}}},
+		{{Key: "$sort", Value: bson.M{"_id": 1}}},
+	}
+}
+
+func (s *CryptoServiceServer) GetVoteHistory(ctx context.Context, req *pb.GetVoteHistoryRequest) (*pb.GetVoteHistoryResponse, error) {
+	objectId, err := bson.ObjectIDFromHex(req.GetId())
+	if err != nil {
+		return nil, apperrors.InvalidArgument("id", "must be a valid ObjectId")
+	}
+
+	to := time.Now()
+	if req.GetTo() != nil {
+		to = req.GetTo().AsTime()
+	}
+	from := to.Add(-defaultHistoryRange)
+	if req.GetFrom() != nil {
+		from = req.GetFrom().AsTime()
+	}
+	if !from.Before(to) {
+		return nil, apperrors.InvalidArgument("from", "must be before to")
+	}
+
+	maxPoints := int(req.GetMaxPoints())
+	if maxPoints <= 0 {
+		maxPoints = defaultHistoryMaxPoints
+	}
+	if maxPoints > maxHistoryMaxPoints {
+		maxPoints = maxHistoryMaxPoints
+	}
+
+	err = s.Db.FindOne(ctx, notDeleted(bson.M{"_id": objectId}), options.FindOne().SetProjection(bson.M{"_id": 1})).Err()
+	if err != nil {
+		return nil, apperrors.FromDB(err, "crypto", req.GetId())
+	}
+
+	bucket := s.SnapshotBucket
+	if bucket <= 0 {
+		bucket = time.Hour
+	}
+	step, origin := historyStep(bucket, resolutionOf(req.GetResolution()), from, to, maxPoints)
+
+	cursor, err := s.Snapshots.Aggregate(ctx, historyPipeline(objectId, from, to, origin, step))
+	if err != nil {
+		return nil, apperrors.FromDB(err, "vote history", req.GetId())
+	}
+
+	defer cursor.Close(ctx)
+
+	response := &pb.GetVoteHistoryResponse{ResolutionSeconds: int64(step / time.Second)}
+	for cursor.Next(ctx) {
+		var point historyPoint
+		if err := cursor.Decode(&point); err != nil {
+			return nil, apperrors.FromDB(err, "vote history", req.GetId())
+		}
+
+		response.Points = append(response.Points, &pb.VoteHistoryPoint{
+			Bucket:   timestamppb.New(point.Bucket),
+			Likes:    point.Likes,
+			Dislikes: point.Dislikes,
+			VoteRate: point.VoteRate,
+		})
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, apperrors.FromDB(err, "vote history", req.GetId())
+	}
+
+	return response, nil
+}
@@ -0,0 +1,215 @@
+package controllers
+
+import (
+	"api/app/pb"
+	"api/apperrors"
+	"api/events"
+	"api/models"
+	"api/ranking"
+	"api/validator"
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	bson "go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultImportBatchSize = 500
+	maxImportErrors        = 1000
+)
+
+type importRow struct {
+	row  int64
+	data models.CryptoItem
+}
+
+func addImportError(summary *pb.ImportCryptosResponse, row int64, symbol string, message string) {
+	summary.Failed++
+	if len(summary.Errors) < maxImportErrors {
+		summary.Errors = append(summary.Errors, &pb.ImportRowError{Row: row, Symbol: symbol, Error: message})
+	}
+}
+
+// importUpsert inserts a new crypto or refreshes the descriptive fields of the
+// existing one with the same symbol. updatedAt only moves when something
+// changed, so unchanged rows are reported as skipped. User input is wrapped in
+// $literal so values starting with "$" are not read as field paths.
+func importUpsert(data models.CryptoItem) mongo.Pipeline {
+	unchanged := bson.M{"$and": bson.A{
+		bson.M{"$eq": bson.A{"$name", bson.M{"$literal": data.Name}}},
+		bson.M{"$eq": bson.A{"$description", bson.M{"$literal": data.Description}}},
+		bson.M{"$eq": bson.A{"$slug", bson.M{"$literal": data.Slug}}},
+	}}
+
+	return mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"updatedAt": bson.M{"$cond": bson.A{unchanged, "$updatedAt", data.UpdatedAt}},
+			"createdAt": bson.M{"$ifNull": bson.A{"$createdAt", data.CreatedAt}},
+			"likes":     bson.M{"$ifNull": bson.A{"$likes", 0}},
+			"dislikes":  bson.M{"$ifNull": bson.A{"$dislikes", 0}},
+			"voteRate":  bson.M{"$ifNull": bson.A{"$voteRate", 0}},
+			"scores":    bson.M{"$ifNull": bson.A{"$scores", ranking.Expression("$$NOW")}},
+		}}},
+		{{Key: "$set", Value: bson.M{
+			"name":        bson.M{"$literal": data.Name},
+			"description": bson.M{"$literal": data.Description},
+			"slug":        bson.M{"$literal": data.Slug},
+		}}},
+		{{Key: "$unset", Value: "deletedAt"}},
+	}
+}
+
+func (s *CryptoServiceServer) flushImport(ctx context.Context, batch []importRow, summary *pb.ImportCryptosResponse) error {
+	if len(batch) == 0 {
+		return nil
+	}
+
+	for len(batch) > 0 {
+		var result *mongo.BulkWriteResult
+		err := s.commit(ctx, func(ctx context.Context) ([]events.Event, error) {
+			writes := make([]mongo.WriteModel, len(batch))
+			for i, row := range batch {
+				writes[i] = mongo.NewUpdateOneModel().
+					SetFilter(notDeleted(bson.M{"symbol": row.data.Symbol})).
+					SetUpdate(importUpsert(row.data)).
+					SetUpsert(true)
+			}
+
+			var err error
+			result, err = s.Db.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+			if err != nil {
+				return nil, err
+			}
+
+			// the bus is told once per import, the outbox once per committed batch
+			if result.UpsertedCount+result.ModifiedCount > 0 {
+				return nil, s.Outbox.Enqueue(ctx, events.Event{Type: events.Imported})
+			}
+			return nil, nil
+		})
+		if result != nil && (err == nil || s.Outbox == nil) {
+			summary.Created += result.UpsertedCount
+			summary.Updated += result.ModifiedCount
+			summary.Skipped += result.MatchedCount - result.ModifiedCount
+		}
+
+		var bulkErr mongo.BulkWriteException
+		if errors.As(err, &bulkErr) && bulkErr.WriteConcernError == nil {
+			failed := map[int]bool{}
+			for _, writeErr := range bulkErr.WriteErrors {
+				row := batch[writeErr.Index]
+				rowErr := apperrors.FromDB(writeErr, "crypto", row.data.Symbol)
+				if mongo.IsDuplicateKeyError(writeErr) {
+					rowErr = s.duplicateError(ctx, writeErr, row.data.Symbol, row.data.Slug)
+				}
+				addImportError(summary, row.row, row.data.Symbol, status.Convert(rowErr).Message())
+				failed[writeErr.Index] = true
+			}
+
+			if s.Outbox == nil {
+				// without a transaction the other rows were written despite the failure
+				return nil
+			}
+
+			// the transaction rolled the batch back: retry without the rejected rows
+			remaining := make([]importRow, 0, len(batch)-len(failed))
+			for i, row := range batch {
+				if !failed[i] {
+					remaining = append(remaining, row)
+				}
+			}
+			batch = remaining
+			continue
+		}
+		if err != nil {
+			if _, ok := status.FromError(err); ok {
+				return err
+			}
+			return apperrors.FromDB(err, "crypto", "")
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func (s *CryptoServiceServer) ImportCryptos(stream pb.CryptoService_ImportCryptosServer) error {
+	ctx := stream.Context()
+	batchSize := s.ImportBatchSize
+	if batchSize <= 0 {
+		batchSize = defaultImportBatchSize
+	}
+
+	summary := &pb.ImportCryptosResponse{}
+	batch := make([]importRow, 0, batchSize)
+	symbols := map[string]bool{}
+
+	flush := func() error {
+		err := s.flushImport(ctx, batch, summary)
+		batch = batch[:0]
+		symbols = map[string]bool{}
+		return err
+	}
+
+	for row := int64(1); ; row++ {
+		item, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		req := &pb.CreateCryptoRequest{
+			Name:        item.GetName(),
+			Description: item.GetDescription(),
+			Symbol:      item.GetSymbol(),
+			Slug:        item.GetSlug(),
+		}
+		if violations := validator.Validate(req); len(violations) > 0 {
+			descriptions := make([]string, len(violations))
+			for i, v := range violations {
+				descriptions[i] = v.GetDescription()
+			}
+			addImportError(summary, row, item.GetSymbol(), strings.Join(descriptions, "; "))
+			continue
+		}
+
+		data, err := newCryptoItem(req)
+		if err != nil {
+			addImportError(summary, row, item.GetSymbol(), status.Convert(err).Message())
+			continue
+		}
+
+		// a symbol repeated within one unordered bulk write would race with itself
+		if symbols[data.Symbol] {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+
+		batch = append(batch, importRow{row: row, data: data})
+		symbols[data.Symbol] = true
+		if len(batch) >= batchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return err
+	}
+
+	if summary.Created+summary.Updated > 0 {
+		s.publish(ctx, events.Event{Type: events.Imported})
+	}
+
+	return stream.SendAndClose(summary)
+}
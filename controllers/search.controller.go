@@ -0,0 +1,240 @@
+package controllers
+
+import (
+	"api/app/pb"
+	"api/apperrors"
+	"api/models"
+	"api/search"
+	"api/utils"
+	"container/heap"
+	"context"
+	"encoding/base64"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	bson "go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 50
+	searchBatchSize       = 1000
+	// maxSearchResults caps how deep pages may go, as every result up to the
+	// end of the page is kept in memory.
+	maxSearchResults = 10000
+)
+
+type searchCandidate struct {
+	models.CryptoItem `bson:",inline"`
+	TextScore         float64 `bson:"textScore"`
+}
+
+type searchResult struct {
+	data       models.CryptoItem
+	score      float64
+	highlights []search.Highlight
+}
+
+func (r searchResult) before(other searchResult) bool {
+	if r.score != other.score {
+		return r.score > other.score
+	}
+	return r.data.Name < other.data.Name
+}
+
+// topResults keeps the best n results seen, with the worst at the root so
+// it can be replaced in O(log n).
+type topResults struct {
+	n       int
+	results []searchResult
+}
+
+func (t *topResults) Len() int           { return len(t.results) }
+func (t *topResults) Less(i, j int) bool { return t.results[j].before(t.results[i]) }
+func (t *topResults) Swap(i, j int)      { t.results[i], t.results[j] = t.results[j], t.results[i] }
+func (t *topResults) Push(x interface{}) { t.results = append(t.results, x.(searchResult)) }
+func (t *topResults) Pop() interface{} {
+	last := t.results[len(t.results)-1]
+	t.results = t.results[:len(t.results)-1]
+	return last
+}
+
+func (t *topResults) add(result searchResult) {
+	switch {
+	case t.n <= 0:
+	case len(t.results) < t.n:
+		heap.Push(t, result)
+	case result.before(t.results[0]):
+		t.results[0] = result
+		heap.Fix(t, 0)
+	}
+}
+
+// sorted returns the kept results, best first.
+func (t *topResults) sorted() []searchResult {
+	sort.Slice(t.results, func(i, j int) bool { return t.results[i].before(t.results[j]) })
+	return t.results
+}
+
+func searchFields(data models.CryptoItem) []search.Field {
+	return []search.Field{
+		{Name: "symbol", Text: data.Symbol, Weight: 3},
+		{Name: "name", Text: data.Name, Weight: 2},
+		{Name: "description", Text: data.Description, Weight: 1},
+	}
+}
+
+func encodePageToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+
+	offset, err := strconv.Atoi(string(raw))
+	if err != nil {
+		return 0, err
+	}
+	if offset < 0 || offset > maxSearchResults {
+		return 0, strconv.ErrRange
+	}
+
+	return offset, nil
+}
+
+// searchQuery builds the MongoDB query narrowing the candidates for a mode.
+// Fuzzy matching cannot be expressed as a query, so it scans the catalog.
+// Only the searched fields are read; the hits are loaded once ranked.
+func searchQuery(query string, mode pb.SearchMode) (bson.M, *options.FindOptions) {
+	projection := bson.M{"name": 1, "symbol": 1, "description": 1}
+	opts := options.Find().SetBatchSize(searchBatchSize).SetProjection(projection)
+
+	switch mode {
+	case pb.SearchMode_PREFIX:
+		prefix := "^" + regexp.QuoteMeta(strings.TrimSpace(query))
+		return notDeleted(bson.M{"$or": bson.A{
+			bson.M{"name": bson.Regex{Pattern: prefix, Options: "i"}},
+			bson.M{"symbol": bson.Regex{Pattern: prefix, Options: "i"}},
+			bson.M{"slug": bson.Regex{Pattern: "^" + regexp.QuoteMeta(utils.Slugify(query))}},
+		}}), opts
+	case pb.SearchMode_FUZZY:
+		return notDeleted(bson.M{}), opts
+	}
+
+	score := bson.M{"$meta": "textScore"}
+	projection["textScore"] = score
+	return notDeleted(bson.M{"$text": bson.M{"$search": query}}), opts
+}
+
+func (s *CryptoServiceServer) SearchCryptos(ctx context.Context, req *pb.SearchCryptosRequest) (*pb.SearchCryptosResponse, error) {
+	terms := search.Terms(req.GetQuery())
+	if len(terms) == 0 {
+		return nil, apperrors.InvalidArgument("query", "must contain letters or digits")
+	}
+
+	offset, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, apperrors.InvalidArgument("page_token", "is invalid")
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultSearchPageSize
+	}
+	if pageSize > maxSearchPageSize {
+		pageSize = maxSearchPageSize
+	}
+
+	mode := req.GetMode()
+	filter, opts := searchQuery(req.GetQuery(), mode)
+	cursor, err := s.Db.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, apperrors.FromDB(err, "crypto", "")
+	}
+
+	defer cursor.Close(ctx)
+
+	// every match is scored so Total and the ranking cover them all, but
+	// only the results up to the end of the requested page are kept
+	n := offset + pageSize
+	if n < offset {
+		return nil, apperrors.InvalidArgument("page_token", "is invalid")
+	}
+	top := &topResults{n: n}
+	total := 0
+	for cursor.Next(ctx) {
+		var candidate searchCandidate
+		if err := cursor.Decode(&candidate); err != nil {
+			return nil, apperrors.FromDB(err, "crypto", "")
+		}
+
+		score, highlights, ok := search.Score(searchFields(candidate.CryptoItem), terms, mode == pb.SearchMode_FUZZY)
+		switch mode {
+		case pb.SearchMode_FUZZY:
+			if !ok {
+				continue
+			}
+		case pb.SearchMode_PREFIX:
+		default:
+			score = candidate.TextScore
+		}
+
+		total++
+		top.add(searchResult{data: candidate.CryptoItem, score: score, highlights: highlights})
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, apperrors.FromDB(err, "crypto", "")
+	}
+
+	results := top.sorted()
+	response := &pb.SearchCryptosResponse{Total: int64(total)}
+	if offset > len(results) {
+		offset = len(results)
+	}
+	if offset+pageSize < total {
+		response.NextPageToken = encodePageToken(offset + pageSize)
+	}
+	results = results[offset:]
+
+	ids := make([]bson.ObjectID, len(results))
+	for i, result := range results {
+		ids[i] = result.data.Id
+	}
+	found, err := s.findByIds(ctx, ids)
+	if err != nil {
+		return nil, apperrors.FromDB(err, "crypto", "")
+	}
+
+	for _, result := range results {
+		data, ok := found[result.data.Id]
+		if !ok {
+			// deleted since it was matched
+			continue
+		}
+
+		hit := &pb.SearchHit{
+			Crypto: cryptoToProto(s.withPending(data)),
+			Score:  result.score,
+		}
+		for _, h := range result.highlights {
+			highlight := &pb.Highlight{Field: h.Field, Text: h.Text}
+			for _, r := range h.Ranges {
+				highlight.Matches = append(highlight.Matches, &pb.MatchRange{Start: int32(r.Start), End: int32(r.End)})
+			}
+			hit.Highlights = append(hit.Highlights, highlight)
+		}
+		response.Hits = append(response.Hits, hit)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,70 @@
+package controllers
+
+import (
+	"api/models"
+	"encoding/base64"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func TestTopResults(t *testing.T) {
+	var all []searchResult
+	for i := 0; i < 500; i++ {
+		all = append(all, searchResult{
+			data:  models.CryptoItem{Name: "C" + strconv.Itoa(i)},
+			score: float64(rand.Intn(50)),
+		})
+	}
+
+	want := append([]searchResult(nil), all...)
+	sort.Slice(want, func(i, j int) bool { return want[i].before(want[j]) })
+
+	for _, n := range []int{0, 1, 20, 499, 500, 800} {
+		top := &topResults{n: n}
+		for _, result := range all {
+			top.add(result)
+		}
+
+		got := top.sorted()
+		expected := want
+		if n < len(want) {
+			expected = want[:n]
+		}
+		if len(got) != len(expected) {
+			t.Fatalf("n=%d: kept %d results, want %d", n, len(got), len(expected))
+		}
+		for i := range got {
+			if got[i].data.Name != expected[i].data.Name {
+				t.Fatalf("n=%d: result %d is %s, want %s", n, i, got[i].data.Name, expected[i].data.Name)
+			}
+		}
+	}
+}
+
+func TestHostilePageToken(t *testing.T) {
+	tokens := []string{
+		base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(math.MaxInt64))),
+		base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(maxSearchResults + 1))),
+		base64.RawURLEncoding.EncodeToString([]byte("-1")),
+		"not base64!",
+	}
+	for _, token := range tokens {
+		if offset, err := decodePageToken(token); err == nil {
+			t.Errorf("token %q decoded to offset %d, want an error", token, offset)
+		}
+	}
+
+	if offset, err := decodePageToken(encodePageToken(maxSearchResults)); err != nil || offset != maxSearchResults {
+		t.Fatalf("the deepest page decoded to %d, %v", offset, err)
+	}
+
+	// a negative size keeps nothing rather than panicking
+	top := &topResults{n: -1}
+	top.add(searchResult{data: models.CryptoItem{Name: "a"}})
+	if len(top.sorted()) != 0 {
+		t.Fatal("kept a result with a negative size")
+	}
+}
@@ -0,0 +1,209 @@
+package controllers
+
+import (
+	"api/app/pb"
+	"api/apperrors"
+	"api/events"
+	"api/models"
+	"api/wallet"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	bson "go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+)
+
+// errVoteUnchanged aborts a signed vote the wallet had already cast.
+var errVoteUnchanged = errors.New("vote unchanged")
+
+func signatureScheme(scheme pb.SignatureScheme) (wallet.Scheme, bool) {
+	switch scheme {
+	case pb.SignatureScheme_ED25519:
+		return wallet.Ed25519, true
+	case pb.SignatureScheme_SECP256K1:
+		return wallet.Secp256k1, true
+	}
+
+	return 0, false
+}
+
+// recordWalletVote stores the vote of address on a crypto and reports
+// whether it changed anything: liking twice, or removing a like the wallet
+// never gave, leaves the counts alone. A held vote is marked so the tally
+// leaves the record out until the vote is reviewed.
+func (s *CryptoServiceServer) recordWalletVote(ctx context.Context, cryptoId bson.ObjectID, address, scheme string, direction pb.VoteDirection, held bool) (bool, error) {
+	field, other := "liked", "disliked"
+	if direction == pb.VoteDirection_DISLIKE || direction == pb.VoteDirection_REMOVE_DISLIKE {
+		field, other = other, field
+	}
+	adding := direction == pb.VoteDirection_LIKE || direction == pb.VoteDirection_DISLIKE
+
+	filter := bson.M{"cryptoId": cryptoId, "address": address, field: true}
+	set := bson.M{field: false, "updatedAt": time.Now()}
+	update := bson.M{"$set": set}
+	if adding {
+		filter[field] = bson.M{"$ne": true}
+		set[field] = true
+		set["scheme"] = scheme
+		update["$setOnInsert"] = bson.M{other: false, "createdAt": time.Now()}
+	}
+	if held {
+		set["held"] = true
+	} else {
+		update["$unset"] = bson.M{"held": ""}
+	}
+
+	// only adding a vote may create the record; when it already holds the
+	// vote the upsert collides with the unique index instead
+	result, err := s.WalletVotes.UpdateOne(ctx, filter, update, options.Update().SetUpsert(adding))
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return result.ModifiedCount+result.UpsertedCount > 0, nil
+}
+
+// approveWalletVote lets the tally count the wallet vote of an approved
+// case.
+func (s *CryptoServiceServer) approveWalletVote(ctx context.Context, record models.FraudCase) error {
+	filter := bson.M{"cryptoId": record.CryptoId, "address": record.Voter, "held": true}
+	_, err := s.WalletVotes.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"updatedAt": time.Now()}, "$unset": bson.M{"held": ""}})
+	return err
+}
+
+// revertWalletVote takes back the wallet vote of a rejected case, so the
+// wallet may cast it again. A wallet that changed its vote since is left
+// alone.
+func (s *CryptoServiceServer) revertWalletVote(ctx context.Context, record models.FraudCase) error {
+	field, value := "liked", false
+	switch record.Direction {
+	case "remove like":
+		value = true
+	case "dislike":
+		field = "disliked"
+	case "remove dislike":
+		field, value = "disliked", true
+	}
+
+	filter := bson.M{"cryptoId": record.CryptoId, "address": record.Voter, field: !value}
+	update := bson.M{"$set": bson.M{field: value, "updatedAt": time.Now()}, "$unset": bson.M{"held": ""}}
+	_, err := s.WalletVotes.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (s *CryptoServiceServer) CastSignedVote(ctx context.Context, req *pb.CastSignedVoteRequest) (*pb.CastSignedVoteResponse, error) {
+	objectId, err := bson.ObjectIDFromHex(req.GetId())
+	if err != nil {
+		return nil, apperrors.InvalidArgument("id", "must be a valid ObjectId")
+	}
+	scheme, ok := signatureScheme(req.GetScheme())
+	if !ok {
+		return nil, apperrors.InvalidArgument("scheme", "must be a supported signature scheme")
+	}
+
+	message := wallet.VoteMessage(req.GetId(), req.GetDirection().String(), req.GetNonce(), req.GetTimestamp())
+	address, err := wallet.Verify(scheme, req.GetPublicKey(), message, req.GetSignature())
+	if errors.Is(err, wallet.ErrInvalidSignature) {
+		return nil, apperrors.New(codes.Unauthenticated, apperrors.ReasonBadSignature, "The signature does not match the vote and public key")
+	}
+	if err != nil {
+		return nil, apperrors.InvalidArgument("public_key", err.Error())
+	}
+
+	signedAt := time.Unix(req.GetTimestamp(), 0)
+	if age := time.Since(signedAt); age > s.SignedVoteMaxAge || age < -s.SignedVoteMaxAge {
+		return nil, apperrors.InvalidArgument("timestamp", "must be within "+s.SignedVoteMaxAge.String()+" of the server time")
+	}
+
+	data, err := s.cachedCrypto(ctx, objectId)
+	if err != nil {
+		return nil, apperrors.FromDB(err, "crypto", req.GetId())
+	}
+
+	// the nonce outlives the window in which its timestamp is accepted
+	nonce := models.VoteNonce{Address: address, Nonce: req.GetNonce(), ExpiresAt: signedAt.Add(s.SignedVoteMaxAge)}
+	if _, err := s.VoteNonces.InsertOne(ctx, nonce); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, apperrors.New(codes.AlreadyExists, apperrors.ReasonNonceReused, "The nonce was already used by this wallet")
+		}
+		return nil, apperrors.FromDB(err, "vote nonce", "")
+	}
+
+	schemeName := strings.ToLower(req.GetScheme().String())
+	likes, dislikes := voteDeltas(req.GetDirection())
+
+	var since time.Time
+	if s.Fraud != nil {
+		if since, err = s.walletSince(ctx, address); err != nil {
+			return nil, apperrors.FromDB(err, "wallet vote", "")
+		}
+	}
+	vote, verdict := s.screenVote(ctx, objectId, likes, dislikes, address, since)
+	if !verdict.Counted() {
+		// the wallet's vote is recorded, but only counts once approved
+		applied, err := s.recordWalletVote(ctx, objectId, address, schemeName, req.GetDirection(), true)
+		if err != nil {
+			return nil, apperrors.FromDB(err, "wallet vote", "")
+		}
+		if !applied {
+			return &pb.CastSignedVoteResponse{Crypto: cryptoToProto(s.withPending(data)), Address: address}, nil
+		}
+		if data, err = s.holdVote(ctx, objectId, vote, verdict); err != nil {
+			return nil, err
+		}
+		return &pb.CastSignedVoteResponse{Crypto: cryptoToProto(data), Address: address, Applied: true}, nil
+	}
+
+	if s.Votes != nil {
+		applied, err := s.recordWalletVote(ctx, objectId, address, schemeName, req.GetDirection(), false)
+		if err != nil {
+			return nil, apperrors.FromDB(err, "wallet vote", "")
+		}
+		if !applied {
+			return &pb.CastSignedVoteResponse{Crypto: cryptoToProto(s.withPending(data)), Address: address}, nil
+		}
+		data, delta, err := s.bufferVote(data, likes, dislikes)
+		if err != nil {
+			return nil, err
+		}
+		s.flagVote(ctx, objectId, vote, verdict, delta.Likes, delta.Dislikes)
+		return &pb.CastSignedVoteResponse{Crypto: cryptoToProto(data), Address: address, Applied: true}, nil
+	}
+
+	before := data
+	err = s.commit(ctx, func(ctx context.Context) ([]events.Event, error) {
+		applied, err := s.recordWalletVote(ctx, objectId, address, schemeName, req.GetDirection(), false)
+		if err != nil {
+			return nil, apperrors.FromDB(err, "wallet vote", "")
+		}
+		if !applied {
+			return nil, errVoteUnchanged
+		}
+
+		if before, data, err = s.updateVote(ctx, objectId, likes, dislikes); err != nil {
+			return nil, apperrors.FromDB(err, "crypto", req.GetId())
+		}
+
+		return []events.Event{s.voteEvent(ctx, before, data)}, nil
+	})
+	if errors.Is(err, errVoteUnchanged) {
+		return &pb.CastSignedVoteResponse{Crypto: cryptoToProto(data), Address: address}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.flagVote(ctx, objectId, vote, verdict, data.Likes-before.Likes, data.Dislikes-before.Dislikes)
+	return &pb.CastSignedVoteResponse{
+		Crypto:  cryptoToProto(data),
+		Address: address,
+		Applied: true,
+	}, nil
+}
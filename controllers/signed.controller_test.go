@@ -0,0 +1,110 @@
+package controllers
+
+import (
+	"api/app/pb"
+	"api/models"
+	"context"
+	"testing"
+
+	bson "go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+// lastUpdate returns the filter and update of the last update command sent
+// to the mock deployment.
+func lastUpdate(mt *mtest.T) (filter, update map[string]interface{}) {
+	var found bool
+	for started := mt.GetStartedEvent(); started != nil; started = mt.GetStartedEvent() {
+		if started.CommandName != "update" {
+			continue
+		}
+		statement := started.Command.Lookup("updates").Array().Index(0).Value().Document()
+		filter, update = map[string]interface{}{}, map[string]interface{}{}
+		if err := statement.Lookup("q").Unmarshal(&filter); err != nil {
+			mt.Fatal(err)
+		}
+		if err := statement.Lookup("u").Unmarshal(&update); err != nil {
+			mt.Fatal(err)
+		}
+		found = true
+	}
+	if !found {
+		mt.Fatal("no update was sent")
+	}
+
+	return filter, update
+}
+
+func TestHeldWalletVote(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	cryptoId := bson.NewObjectID()
+	const address = "0xabc"
+
+	updated := func(mt *mtest.T) *CryptoServiceServer {
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+		return &CryptoServiceServer{WalletVotes: mt.Coll}
+	}
+	field := func(doc map[string]interface{}, operator, name string) (interface{}, bool) {
+		values, _ := doc[operator].(map[string]interface{})
+		value, ok := values[name]
+		return value, ok
+	}
+
+	mt.Run("a held vote is marked", func(mt *mtest.T) {
+		s := updated(mt)
+		if _, err := s.recordWalletVote(context.Background(), cryptoId, address, "eip191", pb.VoteDirection_LIKE, true); err != nil {
+			mt.Fatal(err)
+		}
+		_, update := lastUpdate(mt)
+		if held, _ := field(update, "$set", "held"); held != true {
+			mt.Fatalf("held vote set held to %v, want true", held)
+		}
+		if _, ok := field(update, "$unset", "held"); ok {
+			mt.Fatal("held vote also unset held")
+		}
+	})
+
+	mt.Run("a counted vote clears the mark", func(mt *mtest.T) {
+		s := updated(mt)
+		if _, err := s.recordWalletVote(context.Background(), cryptoId, address, "eip191", pb.VoteDirection_REMOVE_LIKE, false); err != nil {
+			mt.Fatal(err)
+		}
+		_, update := lastUpdate(mt)
+		if _, ok := field(update, "$set", "held"); ok {
+			mt.Fatal("counted vote set held")
+		}
+		if _, ok := field(update, "$unset", "held"); !ok {
+			mt.Fatal("counted vote left held in place")
+		}
+	})
+
+	mt.Run("approving releases only a held vote", func(mt *mtest.T) {
+		s := updated(mt)
+		record := models.FraudCase{CryptoId: cryptoId, Voter: address, Direction: "like"}
+		if err := s.approveWalletVote(context.Background(), record); err != nil {
+			mt.Fatal(err)
+		}
+		filter, update := lastUpdate(mt)
+		if filter["held"] != true || filter["address"] != address {
+			mt.Fatalf("approval matched %v, want the held vote of %s", filter, address)
+		}
+		if _, ok := field(update, "$unset", "held"); !ok {
+			mt.Fatal("approval left held in place")
+		}
+	})
+
+	mt.Run("rejecting takes the vote back and clears the mark", func(mt *mtest.T) {
+		s := updated(mt)
+		record := models.FraudCase{CryptoId: cryptoId, Voter: address, Direction: "like"}
+		if err := s.revertWalletVote(context.Background(), record); err != nil {
+			mt.Fatal(err)
+		}
+		_, update := lastUpdate(mt)
+		if liked, _ := field(update, "$set", "liked"); liked != false {
+			mt.Fatalf("rejection set liked to %v, want false", liked)
+		}
+		if _, ok := field(update, "$unset", "held"); !ok {
+			mt.Fatal("rejection left held in place")
+		}
+	})
+}
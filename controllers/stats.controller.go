@@ -0,0 +1,190 @@
+package controllers
+
+import (
+	"api/app/pb"
+	"api/apperrors"
+	"api/models"
+	"api/ranking"
+	"context"
+
+	bson "go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var (
+	ratioBoundaries = []float64{0, 0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1}
+	voteBoundaries  = []float64{0, 1, 10, 100, 1000, 10000, 100000, 1000000}
+)
+
+type catalogTotals struct {
+	Cryptos      int64   `bson:"cryptos"`
+	Likes        int64   `bson:"likes"`
+	Dislikes     int64   `bson:"dislikes"`
+	AverageRatio float64 `bson:"averageRatio"`
+	Positive     int64   `bson:"positive"`
+	Negative     int64   `bson:"negative"`
+	Neutral      int64   `bson:"neutral"`
+}
+
+type bucketCount struct {
+	Lower float64 `bson:"_id"`
+	Count int64   `bson:"count"`
+}
+
+type catalogStats struct {
+	Totals []catalogTotals `bson:"totals"`
+	Ratios []bucketCount   `bson:"ratios"`
+	Votes  []bucketCount   `bson:"votes"`
+}
+
+func (s *CryptoServiceServer) GetCryptoStats(ctx context.Context, req *pb.GetCryptoStatsRequest) (*pb.GetCryptoStatsResponse, error) {
+	objectId, err := bson.ObjectIDFromHex(req.GetId())
+	if err != nil {
+		return nil, apperrors.InvalidArgument("id", "must be a valid ObjectId")
+	}
+
+	var data models.CryptoItem
+	err = s.Db.FindOne(ctx, notDeleted(bson.M{"_id": objectId})).Decode(&data)
+	if err != nil {
+		return nil, apperrors.FromDB(err, "crypto", req.GetId())
+	}
+	data = s.withPending(data)
+
+	total, err := s.Db.CountDocuments(ctx, notDeleted(bson.M{}))
+	if err != nil {
+		return nil, apperrors.FromDB(err, "crypto", "")
+	}
+	below, err := s.Db.CountDocuments(ctx, notDeleted(bson.M{"voteRate": bson.M{"$lt": data.VoteRate}}))
+	if err != nil {
+		return nil, apperrors.FromDB(err, "crypto", "")
+	}
+	tied, err := s.Db.CountDocuments(ctx, notDeleted(bson.M{"voteRate": data.VoteRate}))
+	if err != nil {
+		return nil, apperrors.FromDB(err, "crypto", "")
+	}
+
+	response := &pb.GetCryptoStatsResponse{
+		Crypto:   cryptoToProto(data),
+		Likes:    data.Likes,
+		Dislikes: data.Dislikes,
+		Total:    data.Likes + data.Dislikes,
+	}
+	if response.Total > 0 {
+		response.Ratio = float64(data.Likes) / float64(response.Total)
+	}
+	response.Controversy = ranking.Compute(data.Likes, data.Dislikes, data.UpdatedAt).Controversy
+	if total > 0 {
+		// the crypto itself is one of the ties and does not count as below itself
+		response.Percentile = 100 * (float64(below) + float64(tied-1)/2) / float64(total)
+	}
+
+	return response, nil
+}
+
+// catalogStatsPipeline computes every figure of GetCatalogStats in a single
+// aggregation, one $facet per group of figures.
+func catalogStatsPipeline() mongo.Pipeline {
+	votes := bson.M{"$add": bson.A{"$likes", "$dislikes"}}
+	ratio := bson.M{"$divide": bson.A{"$likes", votes}}
+	count := func(condition bson.M) bson.M {
+		return bson.M{"$sum": bson.M{"$cond": bson.A{condition, 1, 0}}}
+	}
+
+	return mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"deletedAt": nil}}},
+		{{Key: "$facet", Value: bson.M{
+			"totals": bson.A{
+				bson.M{"$group": bson.M{
+					"_id":          nil,
+					"cryptos":      bson.M{"$sum": 1},
+					"likes":        bson.M{"$sum": "$likes"},
+					"dislikes":     bson.M{"$sum": "$dislikes"},
+					"averageRatio": bson.M{"$avg": bson.M{"$cond": bson.A{bson.M{"$gt": bson.A{votes, 0}}, ratio, nil}}},
+					"positive":     count(bson.M{"$gt": bson.A{"$voteRate", 0}}),
+					"negative":     count(bson.M{"$lt": bson.A{"$voteRate", 0}}),
+					"neutral":      count(bson.M{"$eq": bson.A{"$voteRate", 0}}),
+				}},
+			},
+			// a ratio of exactly 1 falls past the last boundary into the default bucket
+			"ratios": bson.A{
+				bson.M{"$match": bson.M{"$expr": bson.M{"$gt": bson.A{votes, 0}}}},
+				bson.M{"$bucket": bson.M{
+					"groupBy":    ratio,
+					"boundaries": ratioBoundaries,
+					"default":    ratioBoundaries[len(ratioBoundaries)-1],
+					"output":     bson.M{"count": bson.M{"$sum": 1}},
+				}},
+			},
+			"votes": bson.A{
+				bson.M{"$bucket": bson.M{
+					"groupBy":    votes,
+					"boundaries": voteBoundaries,
+					"default":    voteBoundaries[len(voteBoundaries)-1],
+					"output":     bson.M{"count": bson.M{"$sum": 1}},
+				}},
+			},
+		}}},
+	}
+}
+
+// histogram lays counts out over every bucket, empty ones included. The last
+// boundary starts an unbounded bucket unless closed is set, in which case
+// values equal to it are counted in the bucket before.
+func histogram(boundaries []float64, counts []bucketCount, closed bool) []*pb.HistogramBucket {
+	buckets := make([]*pb.HistogramBucket, 0, len(boundaries))
+	for i, lower := range boundaries {
+		if i+1 < len(boundaries) {
+			upper := boundaries[i+1]
+			buckets = append(buckets, &pb.HistogramBucket{Lower: lower, Upper: &upper})
+		} else if !closed {
+			buckets = append(buckets, &pb.HistogramBucket{Lower: lower})
+		}
+	}
+
+	for _, count := range counts {
+		for i := len(buckets) - 1; i >= 0; i-- {
+			if count.Lower >= buckets[i].Lower {
+				buckets[i].Count += count.Count
+				break
+			}
+		}
+	}
+
+	return buckets
+}
+
+func (s *CryptoServiceServer) GetCatalogStats(ctx context.Context, req *pb.GetCatalogStatsRequest) (*pb.GetCatalogStatsResponse, error) {
+	cursor, err := s.Db.Aggregate(ctx, catalogStatsPipeline())
+	if err != nil {
+		return nil, apperrors.FromDB(err, "crypto", "")
+	}
+
+	defer cursor.Close(ctx)
+
+	var stats catalogStats
+	if cursor.Next(ctx) {
+		if err := cursor.Decode(&stats); err != nil {
+			return nil, apperrors.FromDB(err, "crypto", "")
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, apperrors.FromDB(err, "crypto", "")
+	}
+
+	response := &pb.GetCatalogStatsResponse{
+		RatioDistribution: histogram(ratioBoundaries, stats.Ratios, true),
+		VoteDistribution:  histogram(voteBoundaries, stats.Votes, false),
+	}
+	if len(stats.Totals) > 0 {
+		totals := stats.Totals[0]
+		response.Cryptos = totals.Cryptos
+		response.TotalLikes = totals.Likes
+		response.TotalDislikes = totals.Dislikes
+		response.AverageRatio = totals.AverageRatio
+		response.Positive = totals.Positive
+		response.Negative = totals.Negative
+		response.Neutral = totals.Neutral
+	}
+
+	return response, nil
+}
@@ -0,0 +1,39 @@
+package controllers
+
+import (
+	"api/app/pb"
+	"api/suggest"
+	"context"
+)
+
+const (
+	defaultSuggestLimit = 10
+	maxSuggestLimit     = suggest.MaxLimit
+)
+
+func (s *CryptoServiceServer) SuggestCryptos(ctx context.Context, req *pb.SuggestCryptosRequest) (*pb.SuggestCryptosResponse, error) {
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultSuggestLimit
+	}
+	if limit > maxSuggestLimit {
+		limit = maxSuggestLimit
+	}
+
+	response := &pb.SuggestCryptosResponse{}
+	if s.Suggestions == nil {
+		return response, nil
+	}
+
+	for _, entry := range s.Suggestions.Suggest(req.GetPrefix(), limit) {
+		response.Suggestions = append(response.Suggestions, &pb.Suggestion{
+			Id:       entry.Id,
+			Name:     entry.Name,
+			Symbol:   entry.Symbol,
+			Slug:     entry.Slug,
+			VoteRate: entry.VoteRate,
+		})
+	}
+
+	return response, nil
+}
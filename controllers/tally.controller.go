@@ -0,0 +1,113 @@
+package controllers
+
+import (
+	"api/app/pb"
+	"api/apperrors"
+	"api/merkle"
+	"api/models"
+	"context"
+	"errors"
+	"strings"
+
+	bson "go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func tallyToProto(tally models.VoteTally) *pb.VoteTally {
+	return &pb.VoteTally{
+		Version:  tally.Version,
+		Root:     tally.Root,
+		Likes:    tally.Likes,
+		Dislikes: tally.Dislikes,
+		Voters:   tally.Voters,
+		BuiltAt:  timestamppb.New(tally.BuiltAt),
+	}
+}
+
+// latestTally returns the published tally of a crypto, or nil if none was
+// built yet or tallies are disabled.
+func (s *CryptoServiceServer) latestTally(ctx context.Context, cryptoId bson.ObjectID) (*pb.VoteTally, error) {
+	if s.Tallies == nil {
+		return nil, nil
+	}
+
+	var tally models.VoteTally
+	err := s.Tallies.FindOne(ctx, bson.M{"_id": cryptoId}).Decode(&tally)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return tallyToProto(tally), nil
+}
+
+func (s *CryptoServiceServer) GetVoteProof(ctx context.Context, req *pb.GetVoteProofRequest) (*pb.GetVoteProofResponse, error) {
+	objectId, err := bson.ObjectIDFromHex(req.GetId())
+	if err != nil {
+		return nil, apperrors.InvalidArgument("id", "must be a valid ObjectId")
+	}
+	if s.Tallies == nil {
+		return nil, apperrors.NotFound("vote tally", req.GetId())
+	}
+
+	var tally models.VoteTally
+	if err := s.Tallies.FindOne(ctx, bson.M{"_id": objectId}).Decode(&tally); err != nil {
+		return nil, apperrors.FromDB(err, "vote tally", req.GetId())
+	}
+
+	// Ethereum addresses are stored in lower case, but often given
+	// checksummed
+	address := req.GetAddress()
+	if strings.HasPrefix(address, "0x") || strings.HasPrefix(address, "0X") {
+		address = strings.ToLower(address)
+	}
+
+	var leaf models.TallyLeaf
+	err = s.TallyLeaves.FindOne(ctx, bson.M{"cryptoId": objectId, "version": tally.Version, "address": address}).Decode(&leaf)
+	if err != nil {
+		return nil, apperrors.FromDB(err, "wallet vote", req.GetAddress())
+	}
+
+	// the leaf itself and the sibling on each level up to the root
+	siblings := merkle.Siblings(int(tally.Voters), int(leaf.Index))
+	positions := bson.A{bson.M{"level": 0, "index": leaf.Index}}
+	for _, sibling := range siblings {
+		positions = append(positions, bson.M{"level": sibling.Level, "index": sibling.Index})
+	}
+	cursor, err := s.TallyNodes.Find(ctx, bson.M{"cryptoId": objectId, "version": tally.Version, "$or": positions})
+	if err != nil {
+		return nil, apperrors.FromDB(err, "vote tally", req.GetId())
+	}
+	var nodes []models.TallyNode
+	if err := cursor.All(ctx, &nodes); err != nil {
+		return nil, apperrors.FromDB(err, "vote tally", req.GetId())
+	}
+
+	hashes := make(map[merkle.Position][]byte, len(nodes))
+	for _, node := range nodes {
+		hashes[merkle.Position{Level: int(node.Level), Index: int(node.Index)}] = node.Hash
+	}
+	leafHash, ok := hashes[merkle.Position{Index: int(leaf.Index)}]
+	if !ok {
+		return nil, apperrors.NotFound("vote tally", req.GetId())
+	}
+	steps := make([]*pb.ProofStep, len(siblings))
+	for i, sibling := range siblings {
+		hash, ok := hashes[sibling]
+		if !ok {
+			return nil, apperrors.NotFound("vote tally", req.GetId())
+		}
+		steps[i] = &pb.ProofStep{Hash: hash, Left: sibling.Left()}
+	}
+
+	return &pb.GetVoteProofResponse{
+		Tally:    tallyToProto(tally),
+		Liked:    leaf.Liked,
+		Disliked: leaf.Disliked,
+		Leaf:     leafHash,
+		Proof:    steps,
+	}, nil
+}
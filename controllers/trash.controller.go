@@ -0,0 +1,156 @@
+package controllers
+
+import (
+	"api/app/pb"
+	"api/apperrors"
+	"api/events"
+	"api/models"
+	"context"
+	"time"
+
+	bson "go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *CryptoServiceServer) RestoreCrypto(ctx context.Context, req *pb.RestoreCryptoRequest) (*pb.RestoreCryptoResponse, error) {
+	objectId, err := bson.ObjectIDFromHex(req.GetId())
+	if err != nil {
+		return nil, apperrors.InvalidArgument("id", "must be a valid ObjectId")
+	}
+
+	filter := bson.M{"_id": objectId, "deletedAt": bson.M{"$ne": nil}}
+	update := bson.M{
+		"$unset": bson.M{"deletedAt": ""},
+		"$set":   bson.M{"updatedAt": time.Now()},
+	}
+
+	var data models.CryptoItem
+	err = s.commit(ctx, func(ctx context.Context) ([]events.Event, error) {
+		result := s.Db.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
+		if err := result.Decode(&data); err != nil {
+			return nil, apperrors.FromDB(err, "deleted crypto", req.GetId())
+		}
+
+		return []events.Event{cryptoEvent(events.Restored, data)}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RestoreCryptoResponse{
+		Crypto: cryptoToProto(data),
+	}, nil
+}
+
+func (s *CryptoServiceServer) ListDeletedCryptos(req *pb.ListDeletedCryptosRequest, stream pb.CryptoService_ListDeletedCryptosServer) error {
+	filter := bson.M{"deletedAt": bson.M{"$ne": nil}}
+	return s.streamCryptos(stream, filter, bson.M{"deletedAt": -1}, func(data models.CryptoItem) error {
+		response := &pb.ListDeletedCryptosResponse{
+			Crypto: cryptoToProto(data),
+		}
+		if data.DeletedAt != nil {
+			response.DeletedAt = timestamppb.New(*data.DeletedAt)
+		}
+
+		return stream.Send(response)
+	})
+}
+
+func (s *CryptoServiceServer) PurgeCrypto(ctx context.Context, req *pb.PurgeCryptoRequest) (*pb.PurgeCryptoResponse, error) {
+	objectId, err := bson.ObjectIDFromHex(req.GetId())
+	if err != nil {
+		return nil, apperrors.InvalidArgument("id", "must be a valid ObjectId")
+	}
+
+	err = s.commit(ctx, func(ctx context.Context) ([]events.Event, error) {
+		result, err := s.Db.DeleteOne(ctx, bson.M{"_id": objectId, "deletedAt": bson.M{"$ne": nil}})
+		if err != nil {
+			return nil, apperrors.FromDB(err, "deleted crypto", req.GetId())
+		}
+		if result.DeletedCount == 0 {
+			return nil, apperrors.NotFound("deleted crypto", req.GetId())
+		}
+
+		return []events.Event{cryptoEvent(events.Purged, models.CryptoItem{Id: objectId})}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PurgeCryptoResponse{
+		Success: true,
+	}, nil
+}
+
+// PurgeExpired permanently removes up to limit cryptos soft deleted before
+// cutoff, through the same commit as PurgeCrypto so each gets a Purged
+// event, and returns how many it removed.
+func (s *CryptoServiceServer) PurgeExpired(ctx context.Context, cutoff time.Time, limit int) (int64, error) {
+	var purged int64
+	err := s.commit(ctx, func(ctx context.Context) ([]events.Event, error) {
+		filter := bson.M{"deletedAt": bson.M{"$lt": cutoff}}
+		ids, err := s.cryptoIds(ctx, filter, limit)
+		if err != nil || len(ids) == 0 {
+			return nil, err
+		}
+
+		filter["_id"] = bson.M{"$in": ids}
+		result, err := s.Db.DeleteMany(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		purged = result.DeletedCount
+		if purged < int64(len(ids)) {
+			// some were restored in between and are still there
+			kept, err := s.cryptoIds(ctx, bson.M{"_id": bson.M{"$in": ids}}, 0)
+			if err != nil {
+				return nil, err
+			}
+			ids = without(ids, kept)
+		}
+
+		pending := make([]events.Event, len(ids))
+		for i, id := range ids {
+			pending[i] = cryptoEvent(events.Purged, models.CryptoItem{Id: id})
+		}
+		return pending, nil
+	})
+
+	return purged, err
+}
+
+// cryptoIds returns the ids of up to limit cryptos matching filter, or of
+// all of them when limit is 0.
+func (s *CryptoServiceServer) cryptoIds(ctx context.Context, filter bson.M, limit int) ([]bson.ObjectID, error) {
+	opts := options.Find().SetProjection(bson.M{"_id": 1}).SetLimit(int64(limit))
+	cursor, err := s.Db.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var found []models.CryptoItem
+	if err := cursor.All(ctx, &found); err != nil {
+		return nil, err
+	}
+
+	ids := make([]bson.ObjectID, len(found))
+	for i, data := range found {
+		ids[i] = data.Id
+	}
+	return ids, nil
+}
+
+func without(ids, removed []bson.ObjectID) []bson.ObjectID {
+	skip := make(map[bson.ObjectID]bool, len(removed))
+	for _, id := range removed {
+		skip[id] = true
+	}
+
+	var kept []bson.ObjectID
+	for _, id := range ids {
+		if !skip[id] {
+			kept = append(kept, id)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,71 @@
+package controllers
+
+import (
+	"api/app/pb"
+	"api/apperrors"
+	"api/trending"
+	"context"
+
+	bson "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	defaultTrendingLimit = 10
+	maxTrendingLimit     = 100
+)
+
+func trendingWindow(window pb.TrendingWindow) trending.Window {
+	switch window {
+	case pb.TrendingWindow_LAST_HOUR:
+		return trending.Hour
+	case pb.TrendingWindow_LAST_WEEK:
+		return trending.Week
+	}
+
+	return trending.Day
+}
+
+func (s *CryptoServiceServer) ListTrending(ctx context.Context, req *pb.ListTrendingRequest) (*pb.ListTrendingResponse, error) {
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultTrendingLimit
+	}
+	if limit > maxTrendingLimit {
+		limit = maxTrendingLimit
+	}
+
+	response := &pb.ListTrendingResponse{}
+	if s.Trending == nil {
+		return response, nil
+	}
+
+	top := s.Trending.Top(trendingWindow(req.GetWindow()), limit)
+	ids := make([]bson.ObjectID, 0, len(top))
+	for _, entry := range top {
+		if objectId, err := bson.ObjectIDFromHex(entry.Id); err == nil {
+			ids = append(ids, objectId)
+		}
+	}
+
+	found, err := s.findByIds(ctx, ids)
+	if err != nil {
+		return nil, apperrors.FromDB(err, "crypto", "")
+	}
+
+	for _, entry := range top {
+		objectId, _ := bson.ObjectIDFromHex(entry.Id)
+		data, ok := found[objectId]
+		if !ok {
+			continue
+		}
+
+		response.Cryptos = append(response.Cryptos, &pb.TrendingCrypto{
+			Crypto:   cryptoToProto(s.withPending(data)),
+			NetVotes: entry.Net(),
+			Likes:    entry.Likes,
+			Dislikes: entry.Dislikes,
+		})
+	}
+
+	return response, nil
+}
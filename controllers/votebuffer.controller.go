@@ -0,0 +1,131 @@
+package controllers
+
+import (
+	"api/apperrors"
+	"api/events"
+	"api/models"
+	"api/ranking"
+	"api/votebuffer"
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	bson "go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+)
+
+// withPending adds the buffered votes to a stored crypto.
+func (s *CryptoServiceServer) withPending(data models.CryptoItem) models.CryptoItem {
+	if s.Votes == nil {
+		return data
+	}
+
+	pending := s.Votes.Pending(data.Id, data.VoteFlushes[s.VoteNode])
+	if pending == (votebuffer.Delta{}) {
+		return data
+	}
+
+	data.Likes += pending.Likes
+	data.Dislikes += pending.Dislikes
+	data.VoteRate = data.Likes - data.Dislikes
+	data.Scores = ranking.Compute(data.Likes, data.Dislikes, time.Now())
+	return data
+}
+
+// bufferVote records a vote in the vote buffer instead of writing it, and
+// returns the crypto with the vote applied and the change it made.
+func (s *CryptoServiceServer) bufferVote(data models.CryptoItem, likes, dislikes int64) (models.CryptoItem, votebuffer.Delta, error) {
+	base := votebuffer.Delta{Likes: data.Likes, Dislikes: data.Dislikes}
+	applied, err := s.Votes.Add(data.Id, base, data.VoteFlushes[s.VoteNode], votebuffer.Delta{Likes: likes, Dislikes: dislikes})
+	if errors.Is(err, votebuffer.ErrClosed) {
+		return data, applied, apperrors.New(codes.Unavailable, apperrors.ReasonUnavailable, "The server is shutting down")
+	}
+	if err != nil {
+		log.Printf("Could not buffer vote on %s: %v", data.Id.Hex(), err)
+		return data, applied, apperrors.New(codes.Internal, apperrors.ReasonInternal, "Internal error")
+	}
+
+	after := s.withPending(data)
+	if applied != (votebuffer.Delta{}) {
+		event := cryptoEvent(events.Voted, after)
+		event.LikesDelta = applied.Likes
+		event.DislikesDelta = applied.Dislikes
+		s.Events.Publish(event)
+	}
+
+	return after, applied, nil
+}
+
+// FlushVotes writes one segment of buffered votes. Each crypto remembers the
+// last segment applied to it, so a segment flushed again after a failure or
+// a crash is not counted twice. Vote events are recorded per crypto and
+// segment rather than per vote, once even if the segment is flushed again.
+func (s *CryptoServiceServer) FlushVotes(ctx context.Context, seq int64, deltas map[bson.ObjectID]votebuffer.Delta) error {
+	field := "voteFlushes." + s.VoteNode
+	ids := make([]bson.ObjectID, 0, len(deltas))
+	writes := make([]mongo.WriteModel, 0, len(deltas))
+	for id, delta := range deltas {
+		filter := notDeleted(bson.M{"_id": id, "$or": bson.A{
+			bson.M{field: nil},
+			bson.M{field: bson.M{"$lt": seq}},
+		}})
+		update := append(votePipeline(delta.Likes, delta.Dislikes), bson.D{{Key: "$set", Value: bson.M{field: seq}}})
+
+		ids = append(ids, id)
+		writes = append(writes, mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(update))
+	}
+
+	if _, err := s.Db.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false)); err != nil {
+		return err
+	}
+
+	found, err := s.findByIds(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	flushed := make([]events.Event, 0, len(found))
+	votes := make([]interface{}, 0, len(found))
+	now := time.Now()
+	for id, data := range found {
+		event := cryptoEvent(events.Voted, data)
+		event.LikesDelta = deltas[id].Likes
+		event.DislikesDelta = deltas[id].Dislikes
+		flushed = append(flushed, event)
+		votes = append(votes, models.VoteEvent{CryptoId: id, Likes: event.LikesDelta, Dislikes: event.DislikesDelta, At: now, Node: s.VoteNode, Seq: seq})
+	}
+
+	// the bus already heard of each vote when it was buffered
+	if err := s.Outbox.Enqueue(ctx, flushed...); err != nil {
+		return err
+	}
+	s.Cache.Invalidate(ctx, flushed...)
+
+	if s.VoteEvents != nil && len(votes) > 0 {
+		_, err := s.VoteEvents.InsertMany(ctx, votes, options.InsertMany().SetOrdered(false))
+		if err != nil && !onlyDuplicates(err) {
+			log.Printf("Could not record %d flushed votes: %v", len(votes), err)
+		}
+	}
+
+	return nil
+}
+
+// onlyDuplicates reports whether every write of a bulk insert that failed
+// was rejected as a duplicate key.
+func onlyDuplicates(err error) bool {
+	var bulkErr mongo.BulkWriteException
+	if !errors.As(err, &bulkErr) || bulkErr.WriteConcernError != nil {
+		return false
+	}
+	for _, writeErr := range bulkErr.WriteErrors {
+		if !mongo.IsDuplicateKeyError(writeErr) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,49 @@
+package controllers
+
+import (
+	"api/app/pb"
+	"api/events"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const watchBuffer = 64
+
+// WatchCryptos streams crypto events until the client goes away. Changes is
+// fed by the change stream when enabled, so writes from every instance are
+// included. A client too slow to keep up misses events.
+func (s *CryptoServiceServer) WatchCryptos(req *pb.WatchCryptosRequest, stream pb.CryptoService_WatchCryptosServer) error {
+	ids := map[string]bool{}
+	for _, id := range req.GetIds() {
+		ids[id] = true
+	}
+
+	changes := s.Changes.Subscribe(watchBuffer)
+	defer s.Changes.Unsubscribe(changes)
+
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-changes:
+			if len(ids) > 0 && event.Type != events.Imported && !ids[event.CryptoId] {
+				continue
+			}
+
+			response := &pb.WatchCryptosResponse{
+				Type:          string(event.Type),
+				Id:            event.CryptoId,
+				LikesDelta:    event.LikesDelta,
+				DislikesDelta: event.DislikesDelta,
+				OccurredAt:    timestamppb.New(event.OccurredAt),
+			}
+			if !event.Crypto.Id.IsZero() {
+				response.Crypto = cryptoToProto(event.Crypto)
+			}
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package controllers
+
+import (
+	"api/app/pb"
+	"api/apperrors"
+	"api/events"
+	"api/models"
+	"api/webhooks"
+	"context"
+	"net/url"
+	"time"
+
+	bson "go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultDeliveryLimit = 50
+	maxDeliveryLimit     = 500
+)
+
+var webhookEvents = map[pb.WebhookEvent]events.Type{
+	pb.WebhookEvent_CRYPTO_CREATED:  events.Created,
+	pb.WebhookEvent_CRYPTO_UPDATED:  events.Updated,
+	pb.WebhookEvent_CRYPTO_DELETED:  events.Deleted,
+	pb.WebhookEvent_CRYPTO_RESTORED: events.Restored,
+	pb.WebhookEvent_CRYPTO_PURGED:   events.Purged,
+	pb.WebhookEvent_CRYPTO_VOTED:    events.Voted,
+}
+
+var deliveryStatuses = map[pb.DeliveryStatus]string{
+	pb.DeliveryStatus_PENDING:   models.DeliveryPending,
+	pb.DeliveryStatus_DELIVERED: models.DeliveryDelivered,
+	pb.DeliveryStatus_DEAD:      models.DeliveryDead,
+}
+
+type WebhookServiceServer struct {
+	Hooks      *mongo.Collection
+	Deliveries *mongo.Collection
+	pb.UnimplementedWebhookServiceServer
+}
+
+func webhookToProto(hook models.Webhook) *pb.Webhook {
+	result := &pb.Webhook{
+		Id:                 hook.Id.Hex(),
+		Url:                hook.Url,
+		VoteRateThresholds: hook.VoteRateThresholds,
+		CryptoIds:          hook.CryptoIds,
+		CreatedAt:          timestamppb.New(hook.CreatedAt),
+	}
+	for _, eventType := range hook.Events {
+		for event, mapped := range webhookEvents {
+			if string(mapped) == eventType {
+				result.Events = append(result.Events, event)
+			}
+		}
+	}
+
+	return result
+}
+
+func deliveryToProto(delivery models.WebhookDelivery) *pb.WebhookDelivery {
+	result := &pb.WebhookDelivery{
+		Id:             delivery.Id.Hex(),
+		WebhookId:      delivery.WebhookId.Hex(),
+		Event:          delivery.Event,
+		Payload:        delivery.Payload,
+		Attempts:       delivery.Attempts,
+		LastError:      delivery.LastError,
+		LastStatusCode: delivery.LastStatus,
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
+	}
+	for status, mapped := range deliveryStatuses {
+		if mapped == delivery.Status {
+			result.Status = status
+		}
+	}
+	if delivery.Status == models.DeliveryPending {
+		result.NextAttemptAt = timestamppb.New(delivery.NextAttemptAt)
+	}
+	if delivery.DeliveredAt != nil {
+		result.DeliveredAt = timestamppb.New(*delivery.DeliveredAt)
+	}
+
+	return result
+}
+
+func (s *WebhookServiceServer) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	endpoint, err := url.Parse(req.GetUrl())
+	if err != nil || endpoint.Host == "" {
+		return nil, apperrors.InvalidArgument("url", "must be an absolute http or https URL")
+	}
+	if len(req.GetEvents()) == 0 && len(req.GetVoteRateThresholds()) == 0 {
+		return nil, apperrors.InvalidArgument("events", "or vote_rate_thresholds is required")
+	}
+
+	secret, err := webhooks.NewSecret()
+	if err != nil {
+		return nil, apperrors.New(codes.Internal, apperrors.ReasonInternal, "Could not generate the webhook secret")
+	}
+
+	hook := models.Webhook{
+		Url:                endpoint.String(),
+		Secret:             secret,
+		Events:             []string{},
+		VoteRateThresholds: req.GetVoteRateThresholds(),
+		CryptoIds:          req.GetCryptoIds(),
+		CreatedAt:          time.Now(),
+	}
+	for _, event := range req.GetEvents() {
+		eventType, ok := webhookEvents[event]
+		if !ok {
+			return nil, apperrors.InvalidArgument("events", "must not contain WEBHOOK_EVENT_UNSPECIFIED")
+		}
+		hook.Events = append(hook.Events, string(eventType))
+	}
+
+	result, err := s.Hooks.InsertOne(ctx, hook)
+	if err != nil {
+		return nil, apperrors.FromDB(err, "webhook", hook.Url)
+	}
+	hook.Id = result.InsertedID.(bson.ObjectID)
+
+	return &pb.CreateWebhookResponse{
+		Webhook: webhookToProto(hook),
+		Secret:  secret,
+	}, nil
+}
+
+func (s *WebhookServiceServer) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	cursor, err := s.Hooks.Find(ctx, bson.M{}, options.Find().SetSort(bson.M{"createdAt": 1}))
+	if err != nil {
+		return nil, apperrors.FromDB(err, "webhook", "")
+	}
+
+	defer cursor.Close(ctx)
+
+	response := &pb.ListWebhooksResponse{}
+	for cursor.Next(ctx) {
+		var hook models.Webhook
+		if err := cursor.Decode(&hook); err != nil {
+			return nil, apperrors.FromDB(err, "webhook", "")
+		}
+		response.Webhooks = append(response.Webhooks, webhookToProto(hook))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, apperrors.FromDB(err, "webhook", "")
+	}
+
+	return response, nil
+}
+
+// DeleteWebhook removes the webhook; its pending deliveries are given up on
+// by the worker and stay in the log.
+func (s *WebhookServiceServer) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	objectId, err := bson.ObjectIDFromHex(req.GetId())
+	if err != nil {
+		return nil, apperrors.InvalidArgument("id", "must be a valid ObjectId")
+	}
+
+	result, err := s.Hooks.DeleteOne(ctx, bson.M{"_id": objectId})
+	if err != nil {
+		return nil, apperrors.FromDB(err, "webhook", req.GetId())
+	}
+	if result.DeletedCount == 0 {
+		return nil, apperrors.NotFound("webhook", req.GetId())
+	}
+
+	return &pb.DeleteWebhookResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *WebhookServiceServer) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	filter := bson.M{}
+	if req.GetWebhookId() != "" {
+		objectId, err := bson.ObjectIDFromHex(req.GetWebhookId())
+		if err != nil {
+			return nil, apperrors.InvalidArgument("webhook_id", "must be a valid ObjectId")
+		}
+		filter["webhookId"] = objectId
+	}
+	if status, ok := deliveryStatuses[req.GetStatus()]; ok {
+		filter["status"] = status
+	}
+
+	limit := int64(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultDeliveryLimit
+	}
+	if limit > maxDeliveryLimit {
+		limit = maxDeliveryLimit
+	}
+
+	cursor, err := s.Deliveries.Find(ctx, filter, options.Find().SetSort(bson.M{"createdAt": -1}).SetLimit(limit))
+	if err != nil {
+		return nil, apperrors.FromDB(err, "webhook delivery", "")
+	}
+
+	defer cursor.Close(ctx)
+
+	response := &pb.ListWebhookDeliveriesResponse{}
+	for cursor.Next(ctx) {
+		var delivery models.WebhookDelivery
+		if err := cursor.Decode(&delivery); err != nil {
+			return nil, apperrors.FromDB(err, "webhook delivery", "")
+		}
+		response.Deliveries = append(response.Deliveries, deliveryToProto(delivery))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, apperrors.FromDB(err, "webhook delivery", "")
+	}
+
+	return response, nil
+}
+
+// RetryWebhookDelivery moves a dead letter back to the queue with a fresh
+// set of attempts.
+func (s *WebhookServiceServer) RetryWebhookDelivery(ctx context.Context, req *pb.RetryWebhookDeliveryRequest) (*pb.RetryWebhookDeliveryResponse, error) {
+	objectId, err := bson.ObjectIDFromHex(req.GetId())
+	if err != nil {
+		return nil, apperrors.InvalidArgument("id", "must be a valid ObjectId")
+	}
+
+	filter := bson.M{"_id": objectId, "status": models.DeliveryDead}
+	update := bson.M{"$set": bson.M{
+		"status":        models.DeliveryPending,
+		"attempts":      0,
+		"nextAttemptAt": time.Now(),
+	}}
+
+	var delivery models.WebhookDelivery
+	err = s.Deliveries.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&delivery)
+	if err != nil {
+		return nil, apperrors.FromDB(err, "dead webhook delivery", req.GetId())
+	}
+
+	return &pb.RetryWebhookDeliveryResponse{
+		Delivery: deliveryToProto(delivery),
+	}, nil
+}
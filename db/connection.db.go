@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -34,3 +35,17 @@ func Connect() (*mongo.Collection, context.Context, error) {
 
 	return cryptoDb, mongoCtx, nil
 }
+
+// SupportsTransactions reports whether the deployment is a replica set or a
+// sharded cluster; standalone servers reject transactions.
+func SupportsTransactions(ctx context.Context, db *mongo.Database) (bool, error) {
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+	if err := db.RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello); err != nil {
+		return false, err
+	}
+
+	return hello.SetName != "" || hello.Msg == "isdbgrid", nil
+}
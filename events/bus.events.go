@@ -0,0 +1,88 @@
+package events
+
+import (
+	"api/models"
+	"log"
+	"sync"
+	"time"
+)
+
+type Type string
+
+const (
+	Created  Type = "crypto.created"
+	Updated  Type = "crypto.updated"
+	Deleted  Type = "crypto.deleted"
+	Restored Type = "crypto.restored"
+	Purged   Type = "crypto.purged"
+	Voted    Type = "crypto.voted"
+	// Imported signals a bulk change; subscribers should reload what they cache.
+	Imported Type = "catalog.imported"
+)
+
+type Event struct {
+	Type       Type
+	CryptoId   string
+	Crypto     models.CryptoItem
+	OccurredAt time.Time
+	// LikesDelta and DislikesDelta tell by how much a Voted event changed
+	// the counts.
+	LikesDelta    int64
+	DislikesDelta int64
+}
+
+// Bus fans events out to in-process subscribers. Publish never blocks: a
+// subscriber whose buffer is full misses the event, so subscribers that
+// cache data must also refresh periodically.
+type Bus struct {
+	mu          sync.RWMutex
+	subscribers []chan Event
+}
+
+func NewBus() *Bus {
+	return &Bus{}
+}
+
+func (b *Bus) Subscribe(buffer int) <-chan Event {
+	ch := make(chan Event, buffer)
+
+	b.mu.Lock()
+	b.subscribers = append(b.subscribers, ch)
+	b.mu.Unlock()
+
+	return ch
+}
+
+// Unsubscribe stops delivering events to ch and closes it.
+func (b *Bus) Unsubscribe(ch <-chan Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for i, subscriber := range b.subscribers {
+		if subscriber == ch {
+			b.subscribers = append(b.subscribers[:i], b.subscribers[i+1:]...)
+			close(subscriber)
+			return
+		}
+	}
+}
+
+func (b *Bus) Publish(event Event) {
+	if b == nil {
+		return
+	}
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+			log.Printf("Dropped %s event for %s: subscriber is full", event.Type, event.CryptoId)
+		}
+	}
+}
@@ -0,0 +1,368 @@
+package fraud
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+type Action string
+
+const (
+	None Action = ""
+	// Flag counts the vote and keeps it for review.
+	Flag Action = "flag"
+	// Discount does not count the vote until it is approved.
+	Discount Action = "discount"
+	// Quarantine discounts the vote and every vote from its address range
+	// and wallet for a while.
+	Quarantine Action = "quarantine"
+)
+
+// Vote is a vote as seen by the detector.
+type Vote struct {
+	CryptoId string
+	Likes    int64
+	Dislikes int64
+	Ip       string
+	// Network is the /24 or /48 range of Ip, and Asn its autonomous system
+	// when known.
+	Network string
+	Asn     string
+	// Voter is the wallet of a signed vote, and VoterSince when the wallet
+	// first voted.
+	Voter      string
+	VoterSince time.Time
+	At         time.Time
+}
+
+// Sources names the address range and wallet a vote came from, which a
+// quarantine covers. The autonomous system is left out, as it may hold a
+// whole ISP.
+func (v Vote) Sources() []string {
+	var sources []string
+	if v.Network != "" {
+		sources = append(sources, "network:"+v.Network)
+	}
+	if v.Voter != "" {
+		sources = append(sources, "voter:"+v.Voter)
+	}
+
+	return sources
+}
+
+func (v Vote) direction() string {
+	switch {
+	case v.Likes > 0:
+		return "like"
+	case v.Likes < 0:
+		return "remove like"
+	case v.Dislikes > 0:
+		return "dislike"
+	}
+
+	return "remove dislike"
+}
+
+type Verdict struct {
+	// Score is between 0 and 1, combining the score of every rule that
+	// matched.
+	Score   float64
+	Reasons []string
+	Action  Action
+	// Until is when the quarantine this vote started ends.
+	Until time.Time
+}
+
+// Counted reports whether the vote counts right away.
+func (v Verdict) Counted() bool {
+	return v.Action == None || v.Action == Flag
+}
+
+type Config struct {
+	// Votes on a crypto within BurstWindow are suspicious beyond BurstMin
+	// and BurstFactor times their average over the previous BurstBaseline.
+	BurstWindow   time.Duration
+	BurstBaseline time.Duration
+	BurstMin      int
+	BurstFactor   float64
+	// Votes on a crypto from one address range within NetworkWindow are
+	// suspicious beyond NetworkMax, and from one autonomous system beyond
+	// AsnMax.
+	NetworkWindow time.Duration
+	NetworkMax    int
+	AsnMax        int
+	// Wallets younger than NewAccountAge casting the same vote on a crypto
+	// within LockstepWindow are suspicious beyond LockstepMax.
+	NewAccountAge  time.Duration
+	LockstepWindow time.Duration
+	LockstepMax    int
+	// The action taken is the strongest whose score is reached.
+	FlagScore       float64
+	DiscountScore   float64
+	QuarantineScore float64
+	QuarantineFor   time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		BurstWindow:     time.Minute,
+		BurstBaseline:   time.Hour,
+		BurstMin:        30,
+		BurstFactor:     5,
+		NetworkWindow:   10 * time.Minute,
+		NetworkMax:      10,
+		AsnMax:          100,
+		NewAccountAge:   24 * time.Hour,
+		LockstepWindow:  30 * time.Second,
+		LockstepMax:     5,
+		FlagScore:       0.3,
+		DiscountScore:   0.6,
+		QuarantineScore: 0.85,
+		QuarantineFor:   24 * time.Hour,
+	}
+}
+
+// Detector scores votes against the votes it saw recently. Like the trending
+// windows, it only sees the votes cast on its own instance.
+type Detector struct {
+	Config
+	Networks *Networks
+
+	mu sync.Mutex
+	// bursts counts the votes per crypto and BurstWindow slot.
+	bursts map[string]map[int64]int
+	// networks, asns and lockstep hold the times of recent votes per crypto
+	// and address range, per crypto and autonomous system, and per crypto,
+	// direction and new wallet.
+	networks    map[string][]time.Time
+	asns        map[string][]time.Time
+	lockstep    map[string]map[string]time.Time
+	quarantined map[string]time.Time
+}
+
+func NewDetector(config Config, networks *Networks) *Detector {
+	return &Detector{
+		Config:      config,
+		Networks:    networks,
+		bursts:      map[string]map[int64]int{},
+		networks:    map[string][]time.Time{},
+		asns:        map[string][]time.Time{},
+		lockstep:    map[string]map[string]time.Time{},
+		quarantined: map[string]time.Time{},
+	}
+}
+
+// excess scores a count past its limit, from 0 at the limit towards 1.
+func excess(count int, limit float64) float64 {
+	if float64(count) <= limit {
+		return 0
+	}
+
+	return 1 - limit/float64(count)
+}
+
+// Score records a vote and returns how suspicious it is. A vote from a
+// quarantined source is quarantined without being scored; a vote reaching
+// QuarantineScore quarantines its sources, but only when a rule about those
+// sources matched. A burst alone says something about the crypto, not the
+// voter, so it discounts the vote at most.
+func (d *Detector) Score(vote *Vote) Verdict {
+	if vote.At.IsZero() {
+		vote.At = time.Now()
+	}
+	if vote.Network == "" && vote.Ip != "" {
+		vote.Network = Range(vote.Ip)
+		vote.Asn = d.Networks.Asn(vote.Ip)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	bursts, burstLimit := d.recordBurst(*vote)
+	fromNetwork := d.recordRecent(d.networks, *vote, vote.Network)
+	fromAsn := d.recordRecent(d.asns, *vote, vote.Asn)
+	inLockstep := d.recordLockstep(*vote)
+
+	for _, source := range vote.Sources() {
+		if until, ok := d.quarantined[source]; ok && until.After(vote.At) {
+			return Verdict{Score: 1, Reasons: []string{"from quarantined " + source}, Action: Quarantine}
+		}
+	}
+
+	var verdict Verdict
+	clean := 1.0
+	fromSource := false
+	if score := excess(bursts, burstLimit); score > 0 {
+		clean *= 1 - score
+		verdict.Reasons = append(verdict.Reasons, fmt.Sprintf("burst of %d votes within %s, expected at most %.0f", bursts, d.BurstWindow, burstLimit))
+	}
+	if score := excess(fromNetwork, float64(d.NetworkMax)); score > 0 {
+		clean *= 1 - score
+		verdict.Reasons = append(verdict.Reasons, fmt.Sprintf("%d votes from network %s within %s", fromNetwork, vote.Network, d.NetworkWindow))
+		fromSource = true
+	}
+	if score := excess(fromAsn, float64(d.AsnMax)); score > 0 {
+		clean *= 1 - score
+		verdict.Reasons = append(verdict.Reasons, fmt.Sprintf("%d votes from %s within %s", fromAsn, vote.Asn, d.NetworkWindow))
+		fromSource = true
+	}
+	if score := excess(inLockstep, float64(d.LockstepMax)); score > 0 {
+		clean *= 1 - score
+		verdict.Reasons = append(verdict.Reasons, fmt.Sprintf("%d new wallets voted %s within %s", inLockstep, vote.direction(), d.LockstepWindow))
+		fromSource = true
+	}
+	verdict.Score = 1 - clean
+
+	switch {
+	case verdict.Score >= d.QuarantineScore && fromSource:
+		verdict.Action = Quarantine
+		verdict.Until = vote.At.Add(d.QuarantineFor)
+		for _, source := range vote.Sources() {
+			d.quarantined[source] = verdict.Until
+		}
+	case verdict.Score >= d.DiscountScore:
+		verdict.Action = Discount
+	case verdict.Score >= d.FlagScore:
+		verdict.Action = Flag
+	}
+
+	return verdict
+}
+
+// recordBurst returns the votes on the crypto in the current slot and how
+// many are expected at most.
+func (d *Detector) recordBurst(vote Vote) (int, float64) {
+	slots := d.bursts[vote.CryptoId]
+	if slots == nil {
+		slots = map[int64]int{}
+		d.bursts[vote.CryptoId] = slots
+	}
+	current := vote.At.UnixNano() / int64(d.BurstWindow)
+	slots[current]++
+
+	history := int64(d.BurstBaseline / d.BurstWindow)
+	previous := 0
+	for slot, count := range slots {
+		if slot < current-history {
+			delete(slots, slot)
+		} else if slot < current {
+			previous += count
+		}
+	}
+
+	baseline := float64(previous) / math.Max(float64(history), 1)
+	return slots[current], math.Max(float64(d.BurstMin), d.BurstFactor*baseline)
+}
+
+// recordRecent returns the votes on the crypto from source within
+// NetworkWindow.
+func (d *Detector) recordRecent(votes map[string][]time.Time, vote Vote, source string) int {
+	if source == "" {
+		return 0
+	}
+
+	key := vote.CryptoId + " " + source
+	times := recent(votes[key], vote.At.Add(-d.NetworkWindow))
+	votes[key] = append(times, vote.At)
+	return len(votes[key])
+}
+
+func (d *Detector) recordLockstep(vote Vote) int {
+	if vote.Voter == "" || vote.At.Sub(vote.VoterSince) > d.NewAccountAge {
+		return 0
+	}
+
+	key := vote.CryptoId + " " + vote.direction()
+	voters := d.lockstep[key]
+	if voters == nil {
+		voters = map[string]time.Time{}
+		d.lockstep[key] = voters
+	}
+	voters[vote.Voter] = vote.At
+
+	cutoff := vote.At.Add(-d.LockstepWindow)
+	for voter, at := range voters {
+		if at.Before(cutoff) {
+			delete(voters, voter)
+		}
+	}
+	return len(voters)
+}
+
+// recent drops the times before cutoff from a sorted slice.
+func recent(times []time.Time, cutoff time.Time) []time.Time {
+	for len(times) > 0 && times[0].Before(cutoff) {
+		times = times[1:]
+	}
+
+	return times
+}
+
+// Prune forgets the votes too old to matter, so cryptos, networks and
+// wallets that stopped voting do not hold memory.
+func (d *Detector) Prune(now time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	oldest := now.Add(-d.BurstBaseline).UnixNano() / int64(d.BurstWindow)
+	for id, slots := range d.bursts {
+		for slot := range slots {
+			if slot < oldest {
+				delete(slots, slot)
+			}
+		}
+		if len(slots) == 0 {
+			delete(d.bursts, id)
+		}
+	}
+
+	for _, votes := range []map[string][]time.Time{d.networks, d.asns} {
+		for key, times := range votes {
+			if times = recent(times, now.Add(-d.NetworkWindow)); len(times) == 0 {
+				delete(votes, key)
+			} else {
+				votes[key] = times
+			}
+		}
+	}
+
+	cutoff := now.Add(-d.LockstepWindow)
+	for key, voters := range d.lockstep {
+		for voter, at := range voters {
+			if at.Before(cutoff) {
+				delete(voters, voter)
+			}
+		}
+		if len(voters) == 0 {
+			delete(d.lockstep, key)
+		}
+	}
+
+	for source, until := range d.quarantined {
+		if !until.After(now) {
+			delete(d.quarantined, source)
+		}
+	}
+}
+
+// SetQuarantines replaces the quarantined sources with those still under
+// review, so quarantines reach every instance and approving a case lifts
+// them.
+func (d *Detector) SetQuarantines(quarantined map[string]time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.quarantined = quarantined
+}
+
+// Release lifts the quarantine of sources on this instance right away.
+func (d *Detector) Release(sources ...string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, source := range sources {
+		delete(d.quarantined, source)
+	}
+}
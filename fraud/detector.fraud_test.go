@@ -0,0 +1,87 @@
+package fraud
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestQuarantineSparesTheAutonomousSystem(t *testing.T) {
+	networks, err := LoadNetworks(strings.NewReader("203.0.0.0\t203.0.255.255\t64501\tZZ\tISP\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := DefaultConfig()
+	config.BurstMin = 1000
+	config.NetworkMax = 2
+	config.AsnMax = 1000
+	detector := NewDetector(config, networks)
+
+	at := time.Now()
+	var verdict Verdict
+	for i := 0; i < 20; i++ {
+		verdict = detector.Score(&Vote{CryptoId: "a", Likes: 1, Ip: "203.0.113.7", At: at})
+	}
+	if verdict.Action != Quarantine {
+		t.Fatalf("20 votes from one range got %q, want a quarantine", verdict.Action)
+	}
+
+	same := detector.Score(&Vote{CryptoId: "b", Likes: 1, Ip: "203.0.113.8", At: at})
+	if same.Action != Quarantine {
+		t.Fatalf("another address of the range got %q, want it quarantined", same.Action)
+	}
+	neighbour := Vote{CryptoId: "b", Likes: 1, Ip: "203.0.114.8", At: at}
+	if other := detector.Score(&neighbour); other.Action != None {
+		t.Fatalf("another range of the same ISP got %q with reasons %v, want it counted", other.Action, other.Reasons)
+	}
+	if neighbour.Asn != "AS64501" || neighbour.Network != "203.0.114.0/24" {
+		t.Fatalf("vote from network %q in %q", neighbour.Network, neighbour.Asn)
+	}
+}
+
+func TestAsnScores(t *testing.T) {
+	networks, err := LoadNetworks(strings.NewReader("203.0.0.0\t203.0.255.255\t64501\tZZ\tISP\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := DefaultConfig()
+	config.BurstMin = 1000
+	config.AsnMax = 5
+	detector := NewDetector(config, networks)
+
+	// one vote from each of many ranges of the same system
+	at := time.Now()
+	var verdict Verdict
+	for i := 0; i < 20; i++ {
+		verdict = detector.Score(&Vote{CryptoId: "a", Likes: 1, Ip: fmt.Sprintf("203.0.%d.1", 100+i), At: at})
+	}
+	if verdict.Score == 0 || verdict.Action == Quarantine {
+		t.Fatalf("got score %.2f and %q, want the system to score without quarantining", verdict.Score, verdict.Action)
+	}
+	if verdict.Until != (time.Time{}) {
+		t.Fatal("the autonomous system started a quarantine")
+	}
+}
+
+func TestBurstAloneNeverQuarantines(t *testing.T) {
+	detector := NewDetector(DefaultConfig(), nil)
+
+	// a coin going viral: 250 votes within a minute from 250 networks
+	at := time.Now()
+	var verdict Verdict
+	for i := 0; i < 250; i++ {
+		verdict = detector.Score(&Vote{CryptoId: "hyped", Likes: 1, Ip: fmt.Sprintf("198.%d.%d.7", 18+i/250, i%250), At: at})
+	}
+	if verdict.Score < detector.QuarantineScore {
+		t.Fatalf("burst scored %.2f, want it past the quarantine score for the test to mean anything", verdict.Score)
+	}
+	if verdict.Action != Discount || !verdict.Until.IsZero() {
+		t.Fatalf("burst got %q until %s, want a discount without quarantine", verdict.Action, verdict.Until)
+	}
+
+	later := detector.Score(&Vote{CryptoId: "other", Likes: 1, Ip: "198.18.249.7", At: at.Add(time.Hour)})
+	if later.Action != None {
+		t.Fatalf("the last voter got %q on another coin an hour later, want it counted", later.Action)
+	}
+}
@@ -0,0 +1,127 @@
+package fraud
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+type asnRange struct {
+	start net.IP
+	end   net.IP
+	asn   string
+}
+
+// Networks maps addresses to their autonomous system. It only adds a
+// signal: votes are grouped and quarantined by Range, as an autonomous
+// system can hold a whole ISP or cloud provider. A nil Networks knows no
+// autonomous system.
+type Networks struct {
+	ranges []asnRange
+}
+
+// LoadNetworks reads an IP to ASN table with one range per line, as the
+// tab separated start address, end address and AS number, such as the
+// ip2asn tables from iptoasn.com. Ranges of AS 0 are not routed and skipped.
+func LoadNetworks(r io.Reader) (*Networks, error) {
+	networks := &Networks{}
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		fields := strings.Split(scanner.Text(), "\t")
+		if len(fields) < 3 || fields[2] == "0" {
+			continue
+		}
+
+		start, end := net.ParseIP(fields[0]).To16(), net.ParseIP(fields[1]).To16()
+		if start == nil || end == nil {
+			return nil, fmt.Errorf("line %d: invalid address range", line)
+		}
+		networks.ranges = append(networks.ranges, asnRange{start: start, end: end, asn: "AS" + fields[2]})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(networks.ranges, func(i, j int) bool {
+		return bytes.Compare(networks.ranges[i].start, networks.ranges[j].start) < 0
+	})
+	return networks, nil
+}
+
+// Asn returns the autonomous system of an address, or "" if it is unknown.
+func (n *Networks) Asn(address string) string {
+	ip := net.ParseIP(address)
+	if n == nil || ip == nil {
+		return ""
+	}
+
+	ip16 := ip.To16()
+	i := sort.Search(len(n.ranges), func(i int) bool {
+		return bytes.Compare(n.ranges[i].start, ip16) > 0
+	}) - 1
+	if i >= 0 && bytes.Compare(ip16, n.ranges[i].end) <= 0 {
+		return n.ranges[i].asn
+	}
+
+	return ""
+}
+
+// Range returns the /24 (IPv4) or /48 (IPv6) range of an address, or the
+// address itself if it cannot be parsed.
+func Range(address string) string {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return address
+	}
+
+	if ip4 := ip.To4(); ip4 != nil {
+		return (&net.IPNet{IP: ip4.Mask(net.CIDRMask(24, 32)), Mask: net.CIDRMask(24, 32)}).String()
+	}
+	return (&net.IPNet{IP: ip.Mask(net.CIDRMask(48, 128)), Mask: net.CIDRMask(48, 128)}).String()
+}
+
+// ClientIp returns the address a request came from. Behind trustedProxies
+// proxies it is taken from x-forwarded-for, as the address the outermost of
+// them saw: every proxy appends the address it got the request from, so
+// entries left of those are whatever the client sent.
+func ClientIp(ctx context.Context, trustedProxies int) string {
+	if trustedProxies > 0 {
+		md, _ := metadata.FromIncomingContext(ctx)
+		var hops []string
+		for _, header := range md.Get("x-forwarded-for") {
+			for _, hop := range strings.Split(header, ",") {
+				if hop = strings.TrimSpace(hop); hop != "" {
+					hops = append(hops, hop)
+				}
+			}
+		}
+		if len(hops) > 0 {
+			i := len(hops) - trustedProxies
+			if i < 0 {
+				i = 0
+			}
+			return hops[i]
+		}
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
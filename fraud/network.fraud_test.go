@@ -0,0 +1,81 @@
+package fraud
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestClientIp(t *testing.T) {
+	proxy := &net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 4000}
+	incoming := func(forwarded ...string) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: proxy})
+		if len(forwarded) > 0 {
+			md := metadata.MD{}
+			md.Append("x-forwarded-for", forwarded...)
+			ctx = metadata.NewIncomingContext(ctx, md)
+		}
+		return ctx
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		proxies int
+		want    string
+	}{
+		{"no proxy", incoming("198.51.100.7"), 0, "10.0.0.2"},
+		{"no header", incoming(), 1, "10.0.0.2"},
+		{"one proxy", incoming("198.51.100.7"), 1, "198.51.100.7"},
+		{"spoofed entry", incoming("1.2.3.4, 198.51.100.7"), 1, "198.51.100.7"},
+		{"two proxies", incoming("1.2.3.4, 198.51.100.7, 10.0.0.9"), 2, "198.51.100.7"},
+		{"split headers", incoming("1.2.3.4", "198.51.100.7"), 1, "198.51.100.7"},
+		{"fewer hops than proxies", incoming("198.51.100.7"), 3, "198.51.100.7"},
+	}
+	for _, test := range tests {
+		if got := ClientIp(test.ctx, test.proxies); got != test.want {
+			t.Errorf("%s: got %s, want %s", test.name, got, test.want)
+		}
+	}
+}
+
+func TestNetworks(t *testing.T) {
+	table := "1.0.0.0\t1.0.0.255\t13335\tUS\tCLOUDFLARENET\n" +
+		"10.0.0.0\t10.255.255.255\t0\tNone\tNot routed\n" +
+		"2001:db8::\t2001:db8:ffff:ffff:ffff:ffff:ffff:ffff\t64500\tZZ\tDOC\n"
+	networks, err := LoadNetworks(strings.NewReader(table))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	asns := map[string]string{
+		"1.0.0.1":        "AS13335",
+		"1.0.1.1":        "",
+		"10.1.2.3":       "",
+		"2001:db8:1::1":  "AS64500",
+		"not an address": "",
+	}
+	for address, want := range asns {
+		if got := networks.Asn(address); got != want {
+			t.Errorf("Asn(%s) = %q, want %q", address, got, want)
+		}
+	}
+	if got := (*Networks)(nil).Asn("1.0.0.1"); got != "" {
+		t.Errorf("a nil table found %q", got)
+	}
+
+	ranges := map[string]string{
+		"1.0.0.1":       "1.0.0.0/24",
+		"2001:db8:1::1": "2001:db8:1::/48",
+		"garbage":       "garbage",
+	}
+	for address, want := range ranges {
+		if got := Range(address); got != want {
+			t.Errorf("Range(%s) = %q, want %q", address, got, want)
+		}
+	}
+}
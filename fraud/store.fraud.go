@@ -0,0 +1,118 @@
+package fraud
+
+import (
+	"api/models"
+	"context"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Store keeps the fraud cases waiting for review.
+type Store struct {
+	Cases *mongo.Collection
+}
+
+func NewStore(db *mongo.Database) *Store {
+	return &Store{Cases: db.Collection("fraud_cases")}
+}
+
+// Open records a suspicious vote on cryptoId for review. likes and dislikes
+// are what deciding the case changes the counts by: the vote itself when it
+// was held, and the change it made when it counted, which the clamp at zero
+// may have cut short.
+func (s *Store) Open(ctx context.Context, cryptoId primitive.ObjectID, vote Vote, verdict Verdict, likes, dislikes int64) error {
+	record := models.FraudCase{
+		CryptoId:  cryptoId,
+		Likes:     likes,
+		Dislikes:  dislikes,
+		Direction: vote.direction(),
+		Ip:        vote.Ip,
+		Network:   vote.Network,
+		Asn:       vote.Asn,
+		Voter:     vote.Voter,
+		Score:     verdict.Score,
+		Reasons:   verdict.Reasons,
+		Action:    string(verdict.Action),
+		Counted:   verdict.Counted(),
+		Status:    models.FraudCaseOpen,
+		CreatedAt: vote.At,
+	}
+	if !verdict.Until.IsZero() {
+		record.Sources = vote.Sources()
+		record.QuarantinedUntil = &verdict.Until
+	}
+
+	_, err := s.Cases.InsertOne(ctx, record)
+	return err
+}
+
+// Review closes an open case with status and returns it.
+func (s *Store) Review(ctx context.Context, id primitive.ObjectID, status string) (models.FraudCase, error) {
+	filter := bson.M{"_id": id, "status": models.FraudCaseOpen}
+	update := bson.M{"$set": bson.M{"status": status, "reviewedAt": time.Now()}}
+
+	var record models.FraudCase
+	err := s.Cases.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&record)
+	return record, err
+}
+
+// Reopen puts a reviewed case back in the queue, when its decision could not
+// be carried out.
+func (s *Store) Reopen(ctx context.Context, id primitive.ObjectID) error {
+	update := bson.M{"$set": bson.M{"status": models.FraudCaseOpen}, "$unset": bson.M{"reviewedAt": ""}}
+	_, err := s.Cases.UpdateOne(ctx, bson.M{"_id": id}, update)
+	return err
+}
+
+// Quarantines returns the sources quarantined by open cases and until when.
+func (s *Store) Quarantines(ctx context.Context, now time.Time) (map[string]time.Time, error) {
+	filter := bson.M{"status": models.FraudCaseOpen, "quarantinedUntil": bson.M{"$gt": now}}
+	cursor, err := s.Cases.Find(ctx, filter, options.Find().SetProjection(bson.M{"sources": 1, "quarantinedUntil": 1}))
+	if err != nil {
+		return nil, err
+	}
+	var records []models.FraudCase
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+
+	quarantined := map[string]time.Time{}
+	for _, record := range records {
+		for _, source := range record.Sources {
+			if until := *record.QuarantinedUntil; until.After(quarantined[source]) {
+				quarantined[source] = until
+			}
+		}
+	}
+
+	return quarantined, nil
+}
+
+// Run prunes the detector and reloads the quarantines from the store every
+// interval until ctx is cancelled.
+func Run(ctx context.Context, store *Store, detector *Detector, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		now := time.Now()
+		detector.Prune(now)
+		quarantined, err := store.Quarantines(ctx, now)
+		if err != nil {
+			log.Printf("Could not load fraud quarantines: %v", err)
+		} else {
+			detector.SetQuarantines(quarantined)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package idempotency
+
+import (
+	"api/app/pb"
+	"api/apperrors"
+	"api/models"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// KeyHeader is the metadata entry carrying the client's key.
+	KeyHeader = "idempotency-key"
+	// ReplayedHeader is set on responses replayed from a stored record.
+	ReplayedHeader = "idempotent-replayed"
+	maxKeyLength   = 200
+)
+
+// mutatingMethod returns the method behind a "/package.Service/Method" name
+// when it is marked with the (mutating) option in the proto files.
+func mutatingMethod(fullMethod string) protoreflect.MethodDescriptor {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", "."))
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return nil
+	}
+
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil
+	}
+
+	if mutating, _ := proto.GetExtension(method.Options(), pb.E_Mutating).(bool); !mutating {
+		return nil
+	}
+	return method
+}
+
+// transient reports whether a failure may not happen again, in which case
+// it is not stored. The handler may have written before failing, so the key
+// is not released either: it stays pending until its lease expires, and only
+// then does a retry run the request again.
+func transient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled, codes.Aborted,
+		codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true
+	}
+
+	return false
+}
+
+// refused reports whether a call was turned down before it ran, for want of
+// a solved vote challenge. Nothing was written, so the key is forgotten and
+// a retry with a solution runs under the same key.
+func refused(err error) bool {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info.Reason == apperrors.ReasonChallengeRequired || info.Reason == apperrors.ReasonChallengeFailed
+		}
+	}
+
+	return false
+}
+
+func replay(ctx context.Context, method protoreflect.MethodDescriptor, record *models.IdempotencyRecord, hash []byte) (interface{}, error) {
+	if !bytes.Equal(record.RequestHash, hash) {
+		return nil, apperrors.New(codes.Aborted, apperrors.ReasonKeyReused, "The idempotency key was already used for a different request")
+	}
+	if record.State != models.IdempotencyDone {
+		return nil, apperrors.New(codes.Aborted, apperrors.ReasonInProgress, "A request with this idempotency key is still in progress",
+			&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Second)},
+		)
+	}
+
+	grpc.SetHeader(ctx, metadata.Pairs(ReplayedHeader, "true"))
+
+	if len(record.Status) > 0 {
+		var st spb.Status
+		if err := proto.Unmarshal(record.Status, &st); err != nil {
+			return nil, err
+		}
+		return nil, status.FromProto(&st).Err()
+	}
+
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(method.Output().FullName())
+	if err != nil {
+		return nil, err
+	}
+	response := messageType.New().Interface()
+	if err := proto.Unmarshal(record.Response, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// StreamServerInterceptor rejects idempotency keys on streaming methods: a
+// stream writes as it reads, before its payload is known, so it cannot be
+// matched against an earlier attempt.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		md, _ := metadata.FromIncomingContext(ss.Context())
+		if values := md.Get(KeyHeader); len(values) > 0 && values[0] != "" {
+			return apperrors.InvalidArgument(KeyHeader, "is not supported on streaming methods")
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+// UnaryServerInterceptor runs mutating methods at most once per
+// idempotency key within the store's window: a retry with the same key and
+// payload gets the original response or error, one with another payload
+// fails with Aborted. Requests without a key run as usual.
+func UnaryServerInterceptor(store *Store) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method := mutatingMethod(info.FullMethod)
+		if store == nil || method == nil {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get(KeyHeader)
+		if len(values) == 0 || values[0] == "" {
+			return handler(ctx, req)
+		}
+		key := values[0]
+		if len(key) > maxKeyLength {
+			return nil, apperrors.InvalidArgument(KeyHeader, "must be at most 200 characters")
+		}
+
+		message, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+		payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+		hash := sha256.Sum256(payload)
+
+		id := info.FullMethod + " " + key
+		record, err := store.claim(ctx, id, hash[:])
+		if err != nil {
+			return nil, apperrors.FromDB(err, "idempotency key", key)
+		}
+		if record != nil {
+			return replay(ctx, method, record, hash[:])
+		}
+
+		response, err := handler(ctx, req)
+
+		// the outcome is stored even when the client gave up waiting
+		storeCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err != nil && transient(err) {
+			return response, err
+		}
+		if err != nil && refused(err) {
+			if storeErr := store.forget(storeCtx, id); storeErr != nil {
+				log.Printf("Could not release idempotency key %s: %v", key, storeErr)
+			}
+			return response, err
+		}
+
+		var encoded, encodedStatus []byte
+		if err != nil {
+			encodedStatus, _ = proto.Marshal(status.Convert(err).Proto())
+		} else if message, ok := response.(proto.Message); ok {
+			encoded, _ = proto.MarshalOptions{Deterministic: true}.Marshal(message)
+		}
+		if storeErr := store.complete(storeCtx, id, encoded, encodedStatus); storeErr != nil {
+			log.Printf("Could not store the response for idempotency key %s: %v", key, storeErr)
+		}
+
+		return response, err
+	}
+}
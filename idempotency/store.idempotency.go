@@ -0,0 +1,90 @@
+package idempotency
+
+import (
+	"api/models"
+	"bytes"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// lease is how long a request holds its key before another attempt may
+// assume it crashed and take over.
+const lease = time.Minute
+
+// Store keeps idempotency records for Window after the first attempt.
+type Store struct {
+	Records *mongo.Collection
+	Window  time.Duration
+}
+
+func NewStore(db *mongo.Database, window time.Duration) *Store {
+	return &Store{Records: db.Collection("idempotency_keys"), Window: window}
+}
+
+// claim takes id for a new attempt. When the key is already known it
+// returns the stored record instead, unless that record expired or its
+// attempt outlived its lease, in which case the key is taken over.
+func (s *Store) claim(ctx context.Context, id string, hash []byte) (*models.IdempotencyRecord, error) {
+	now := time.Now()
+	record := models.IdempotencyRecord{
+		Id:          id,
+		RequestHash: hash,
+		State:       models.IdempotencyPending,
+		LockedUntil: now.Add(lease),
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(s.Window),
+	}
+
+	_, err := s.Records.InsertOne(ctx, record)
+	if err == nil {
+		return nil, nil
+	}
+	if !mongo.IsDuplicateKeyError(err) {
+		return nil, err
+	}
+
+	var existing models.IdempotencyRecord
+	if err := s.Records.FindOne(ctx, bson.M{"_id": id}).Decode(&existing); err != nil {
+		return nil, err
+	}
+
+	expired := existing.ExpiresAt.Before(now)
+	abandoned := existing.State == models.IdempotencyPending && existing.LockedUntil.Before(now) && bytes.Equal(existing.RequestHash, hash)
+	if !expired && !abandoned {
+		return &existing, nil
+	}
+
+	// the filter makes concurrent takeovers race for a single winner
+	filter := bson.M{"_id": id, "createdAt": existing.CreatedAt, "lockedUntil": existing.LockedUntil}
+	result, err := s.Records.ReplaceOne(ctx, filter, record)
+	if err != nil {
+		return nil, err
+	}
+	if result.ModifiedCount == 0 {
+		return s.claim(ctx, id, hash)
+	}
+
+	return nil, nil
+}
+
+// complete stores the outcome of the attempt holding id.
+func (s *Store) complete(ctx context.Context, id string, response, status []byte) error {
+	update := bson.M{"$set": bson.M{
+		"state":    models.IdempotencyDone,
+		"response": response,
+		"status":   status,
+	}}
+
+	_, err := s.Records.UpdateOne(ctx, bson.M{"_id": id, "state": models.IdempotencyPending}, update)
+	return err
+}
+
+// forget drops the pending record of the attempt holding id, so the key can
+// be used again right away.
+func (s *Store) forget(ctx context.Context, id string) error {
+	_, err := s.Records.DeleteOne(ctx, bson.M{"_id": id, "state": models.IdempotencyPending})
+	return err
+}
@@ -0,0 +1,49 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// purgeBatch is how many cryptos a purge removes per commit.
+const purgeBatch = 500
+
+// Purger permanently removes up to limit cryptos soft deleted before cutoff
+// and returns how many it removed.
+type Purger func(ctx context.Context, cutoff time.Time, limit int) (int64, error)
+
+// PurgeDeleted permanently removes cryptos that were soft deleted before
+// now minus retention, in batches.
+func PurgeDeleted(ctx context.Context, purge Purger, retention time.Duration) (int64, error) {
+	cutoff := time.Now().Add(-retention)
+	var total int64
+	for {
+		count, err := purge(ctx, cutoff, purgeBatch)
+		total += count
+		if err != nil || count < purgeBatch {
+			return total, err
+		}
+	}
+}
+
+// StartRetention runs PurgeDeleted every interval until ctx is cancelled.
+func StartRetention(ctx context.Context, purge Purger, retention, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		count, err := PurgeDeleted(ctx, purge, retention)
+		if err != nil {
+			log.Printf("Could not purge deleted cryptos: %v", err)
+		} else if count > 0 {
+			log.Printf("Purged %d deleted crypto(s)", count)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPurgeDeletedBatches(t *testing.T) {
+	left := int64(2*purgeBatch + 7)
+	var calls int
+	purge := func(ctx context.Context, cutoff time.Time, limit int) (int64, error) {
+		calls++
+		count := left
+		if count > int64(limit) {
+			count = int64(limit)
+		}
+		left -= count
+		return count, nil
+	}
+
+	total, err := PurgeDeleted(context.Background(), purge, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 2*purgeBatch+7 || calls != 3 {
+		t.Fatalf("purged %d in %d batches, want %d in 3", total, calls, 2*purgeBatch+7)
+	}
+
+	failed := errors.New("unavailable")
+	calls = 0
+	total, err = PurgeDeleted(context.Background(), func(ctx context.Context, cutoff time.Time, limit int) (int64, error) {
+		calls++
+		if calls == 2 {
+			return 0, failed
+		}
+		return int64(limit), nil
+	}, time.Hour)
+	if !errors.Is(err, failed) || total != purgeBatch {
+		t.Fatalf("got %d purged and %v, want %d and the error", total, err, purgeBatch)
+	}
+}
@@ -0,0 +1,107 @@
+package jobs
+
+import (
+	"api/events"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ParseBucket converts a snapshot bucket name (minute, hour or day) into its
+// duration.
+func ParseBucket(name string) (time.Duration, error) {
+	switch strings.ToLower(name) {
+	case "minute":
+		return time.Minute, nil
+	case "hour":
+		return time.Hour, nil
+	case "day":
+		return 24 * time.Hour, nil
+	}
+
+	return 0, fmt.Errorf("unknown snapshot bucket %q, expected minute, hour or day", name)
+}
+
+// SnapshotVotes records the current counts of every crypto that is not in the
+// trash in the bucket containing now, overwriting an earlier snapshot of the
+// same bucket.
+func SnapshotVotes(ctx context.Context, db, snapshots *mongo.Collection, bucket time.Duration) error {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"deletedAt": nil}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":      0,
+			"cryptoId": "$_id",
+			"bucket":   time.Now().UTC().Truncate(bucket),
+			"likes":    bson.M{"$ifNull": bson.A{"$likes", 0}},
+			"dislikes": bson.M{"$ifNull": bson.A{"$dislikes", 0}},
+			"voteRate": bson.M{"$ifNull": bson.A{"$voteRate", 0}},
+		}}},
+		{{Key: "$merge", Value: bson.M{
+			"into":           snapshots.Name(),
+			"on":             bson.A{"cryptoId", "bucket"},
+			"whenMatched":    "merge",
+			"whenNotMatched": "insert",
+		}}},
+	}
+
+	cursor, err := db.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+
+	return cursor.Close(ctx)
+}
+
+// recordVote refreshes the snapshot of the current bucket for a crypto whose
+// votes just changed, so a bucket ends with the last counts seen in it.
+func recordVote(ctx context.Context, snapshots *mongo.Collection, event events.Event, bucket time.Duration) error {
+	filter := bson.M{
+		"cryptoId": event.Crypto.Id,
+		"bucket":   event.OccurredAt.UTC().Truncate(bucket),
+	}
+	update := bson.M{"$set": bson.M{
+		"likes":    event.Crypto.Likes,
+		"dislikes": event.Crypto.Dislikes,
+		"voteRate": event.Crypto.VoteRate,
+	}}
+
+	_, err := snapshots.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+// StartSnapshots snapshots every crypto at the start of each bucket and keeps
+// the current bucket up to date from vote events until ctx is cancelled.
+func StartSnapshots(ctx context.Context, db, snapshots *mongo.Collection, bucket time.Duration, changes <-chan events.Event) {
+	snapshot := func() {
+		if err := SnapshotVotes(ctx, db, snapshots, bucket); err != nil && ctx.Err() == nil {
+			log.Printf("Could not snapshot votes: %v", err)
+		}
+	}
+
+	snapshot()
+	next := time.NewTimer(time.Until(time.Now().Truncate(bucket).Add(bucket)))
+	defer next.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-next.C:
+			snapshot()
+			next.Reset(time.Until(time.Now().Truncate(bucket).Add(bucket)))
+		case event := <-changes:
+			if event.Type != events.Voted {
+				continue
+			}
+			if err := recordVote(ctx, snapshots, event, bucket); err != nil && ctx.Err() == nil {
+				log.Printf("Could not record vote snapshot for %s: %v", event.CryptoId, err)
+			}
+		}
+	}
+}
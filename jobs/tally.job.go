@@ -0,0 +1,158 @@
+package jobs
+
+import (
+	"api/merkle"
+	"api/models"
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const tallyBatch = 1000
+
+// BuildTally counts the wallet votes on a crypto, leaving out those held
+// for fraud review, stores them as the leaves of a Merkle tree ordered by
+// address, along with the hashes of every level of the tree, and publishes
+// the tally with the root of that tree. The leaves and nodes of the tally
+// it replaces are kept, so proofs requested while it is published still
+// resolve.
+func BuildTally(ctx context.Context, walletVotes, tallies, leaves, nodes *mongo.Collection, cryptoId primitive.ObjectID) error {
+	filter := bson.M{"cryptoId": cryptoId, "held": bson.M{"$ne": true}, "$or": bson.A{bson.M{"liked": true}, bson.M{"disliked": true}}}
+	cursor, err := walletVotes.Find(ctx, filter, options.Find().SetSort(bson.M{"address": 1}))
+	if err != nil {
+		return err
+	}
+	var votes []models.WalletVote
+	if err := cursor.All(ctx, &votes); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	tally := models.VoteTally{CryptoId: cryptoId, Version: now.UnixNano(), Voters: int64(len(votes)), BuiltAt: now}
+	hashes := make([][]byte, len(votes))
+	docs := make([]interface{}, len(votes))
+	for i, vote := range votes {
+		hashes[i] = merkle.LeafHash(merkle.Vote{CryptoId: cryptoId.Hex(), Address: vote.Address, Liked: vote.Liked, Disliked: vote.Disliked})
+		docs[i] = models.TallyLeaf{CryptoId: cryptoId, Version: tally.Version, Index: int64(i), Address: vote.Address, Liked: vote.Liked, Disliked: vote.Disliked}
+		if vote.Liked {
+			tally.Likes++
+		}
+		if vote.Disliked {
+			tally.Dislikes++
+		}
+	}
+	tally.Root = merkle.Root(hashes)
+
+	var levels []interface{}
+	for level, row := range merkle.Levels(hashes) {
+		for i, hash := range row {
+			levels = append(levels, models.TallyNode{CryptoId: cryptoId, Version: tally.Version, Level: int64(level), Index: int64(i), Hash: hash})
+		}
+	}
+
+	if err := insertBatches(ctx, leaves, docs); err != nil {
+		return err
+	}
+	if err := insertBatches(ctx, nodes, levels); err != nil {
+		return err
+	}
+
+	var previous models.VoteTally
+	err = tallies.FindOne(ctx, bson.M{"_id": cryptoId}).Decode(&previous)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return err
+	}
+	tally.PreviousVersion = previous.Version
+
+	// another instance may publish in between; the upsert then collides
+	// with its tally and this one is dropped
+	replace := bson.M{"_id": cryptoId, "version": previous.Version}
+	_, err = tallies.ReplaceOne(ctx, replace, tally, options.Replace().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		return deleteVersions(ctx, leaves, nodes, bson.M{"cryptoId": cryptoId, "version": tally.Version})
+	}
+	if err != nil {
+		return err
+	}
+
+	stale := bson.M{"cryptoId": cryptoId, "version": bson.M{"$nin": bson.A{tally.Version, previous.Version}}}
+	return deleteVersions(ctx, leaves, nodes, stale)
+}
+
+func insertBatches(ctx context.Context, collection *mongo.Collection, docs []interface{}) error {
+	for start := 0; start < len(docs); start += tallyBatch {
+		end := start + tallyBatch
+		if end > len(docs) {
+			end = len(docs)
+		}
+		if _, err := collection.InsertMany(ctx, docs[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteVersions(ctx context.Context, leaves, nodes *mongo.Collection, filter bson.M) error {
+	if _, err := leaves.DeleteMany(ctx, filter); err != nil {
+		return err
+	}
+	_, err := nodes.DeleteMany(ctx, filter)
+	return err
+}
+
+// BuildTallies publishes a new tally for every crypto whose wallet votes
+// changed at or after since, and returns how many it published.
+func BuildTallies(ctx context.Context, walletVotes, tallies, leaves, nodes *mongo.Collection, since time.Time) (int, error) {
+	ids, err := walletVotes.Distinct(ctx, "cryptoId", bson.M{"updatedAt": bson.M{"$gte": since}})
+	if err != nil {
+		return 0, err
+	}
+
+	built := 0
+	for _, id := range ids {
+		cryptoId, ok := id.(primitive.ObjectID)
+		if !ok {
+			continue
+		}
+		if err := BuildTally(ctx, walletVotes, tallies, leaves, nodes, cryptoId); err != nil {
+			return built, err
+		}
+		built++
+	}
+
+	return built, nil
+}
+
+// StartTallies publishes the tallies of every crypto with wallet votes, then
+// every interval those whose votes changed, until ctx is cancelled.
+func StartTallies(ctx context.Context, walletVotes, tallies, leaves, nodes *mongo.Collection, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var since time.Time
+	for {
+		started := time.Now()
+		count, err := BuildTallies(ctx, walletVotes, tallies, leaves, nodes, since)
+		if err != nil {
+			log.Printf("Could not build vote tallies: %v", err)
+		} else {
+			since = started
+		}
+		if count > 0 {
+			log.Printf("Published %d vote tallies", count)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
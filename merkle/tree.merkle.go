@@ -0,0 +1,85 @@
+package merkle
+
+import (
+	"crypto/sha256"
+)
+
+// Position locates a node of the tree by its level, counting up from the
+// leaves, and its index within that level.
+type Position struct {
+	Level int
+	Index int
+}
+
+// Root returns the root of the tree over leaves. A node without a sibling is
+// carried up to the next level unchanged; the root of no leaves is the
+// SHA-256 of nothing.
+func Root(leaves [][]byte) []byte {
+	if len(leaves) == 0 {
+		hash := sha256.Sum256(nil)
+		return hash[:]
+	}
+
+	levels := Levels(leaves)
+	return levels[len(levels)-1][0]
+}
+
+// Levels returns every level of the tree over leaves, from the leaves up to
+// the root.
+func Levels(leaves [][]byte) [][][]byte {
+	levels := [][][]byte{leaves}
+	for level := leaves; len(level) > 1; {
+		level = parents(level)
+		levels = append(levels, level)
+	}
+
+	return levels
+}
+
+// Siblings returns the positions of the nodes a proof of the leaf at index
+// is made of, in a tree over size leaves: the sibling of each node on the
+// way up to the root, skipping those carried up without one.
+func Siblings(size, index int) []Position {
+	var siblings []Position
+	for level := 0; size > 1; level++ {
+		if sibling := index ^ 1; sibling < size {
+			siblings = append(siblings, Position{Level: level, Index: sibling})
+		}
+
+		size = (size + 1) / 2
+		index /= 2
+	}
+
+	return siblings
+}
+
+// Prove returns the proof that the leaf at index is part of the tree.
+func Prove(leaves [][]byte, index int) []Step {
+	levels := Levels(leaves)
+	siblings := Siblings(len(leaves), index)
+
+	proof := make([]Step, len(siblings))
+	for i, sibling := range siblings {
+		proof[i] = Step{Hash: levels[sibling.Level][sibling.Index], Left: sibling.Left()}
+	}
+
+	return proof
+}
+
+// Left reports whether the node is the left child of its parent.
+func (p Position) Left() bool {
+	return p.Index%2 == 0
+}
+
+func parents(level [][]byte) [][]byte {
+	next := make([][]byte, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			next = append(next, level[i])
+		} else {
+			next = append(next, nodeHash(level[i], level[i+1]))
+		}
+	}
+
+	return next
+}
@@ -0,0 +1,59 @@
+package merkle
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+)
+
+func votes(n int) [][]byte {
+	leaves := make([][]byte, n)
+	for i := range leaves {
+		leaves[i] = LeafHash(Vote{CryptoId: "65f000000000000000000001", Address: "wallet" + strconv.Itoa(i), Liked: i%2 == 0, Disliked: i%2 == 1})
+	}
+	return leaves
+}
+
+func TestProve(t *testing.T) {
+	for size := 1; size <= 33; size++ {
+		leaves := votes(size)
+		root := Root(leaves)
+		for index := range leaves {
+			proof := Prove(leaves, index)
+			if !Verify(root, leaves[index], proof) {
+				t.Fatalf("size %d: the proof of leaf %d does not verify", size, index)
+			}
+
+			other := leaves[(index+1)%size]
+			if size > 1 && Verify(root, other, proof) {
+				t.Fatalf("size %d: the proof of leaf %d verifies another leaf", size, index)
+			}
+		}
+	}
+}
+
+func TestSiblingsAddressLevels(t *testing.T) {
+	leaves := votes(11)
+	levels := Levels(leaves)
+	if len(levels) != 5 || len(levels[4]) != 1 || !bytes.Equal(levels[4][0], Root(leaves)) {
+		t.Fatalf("got %d levels, want 5 ending at the root", len(levels))
+	}
+
+	// leaf 10 has no sibling among the leaves, nor its ancestor on level 2
+	want := []Position{{Level: 1, Index: 4}, {Level: 3, Index: 0}}
+	got := Siblings(len(leaves), 10)
+	if len(got) != len(want) {
+		t.Fatalf("siblings %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("siblings %v, want %v", got, want)
+		}
+	}
+}
+
+func TestRootOfNothing(t *testing.T) {
+	if got := Root(nil); !bytes.Equal(got, Root([][]byte{})) || len(got) != 32 {
+		t.Fatalf("root of no leaves %x", got)
+	}
+}
@@ -0,0 +1,70 @@
+package merkle
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"strconv"
+)
+
+// Leaves and inner nodes are hashed with different prefixes, as in RFC 6962,
+// so an inner node can never pass for a leaf.
+const (
+	leafPrefix = 0
+	nodePrefix = 1
+)
+
+// Vote is the vote of one wallet on one crypto, as counted in a tally.
+type Vote struct {
+	CryptoId string
+	Address  string
+	Liked    bool
+	Disliked bool
+}
+
+// Step is one level of a proof: the hash of the sibling and whether the
+// sibling is on the left.
+type Step struct {
+	Hash []byte
+	Left bool
+}
+
+// LeafHash hashes a vote into a leaf of the tree.
+func LeafHash(vote Vote) []byte {
+	data := []byte{leafPrefix}
+	data = append(data, "crypto:"+vote.CryptoId+"\n"...)
+	data = append(data, "address:"+vote.Address+"\n"...)
+	data = append(data, "liked:"+strconv.FormatBool(vote.Liked)+"\n"...)
+	data = append(data, "disliked:"+strconv.FormatBool(vote.Disliked)...)
+
+	hash := sha256.Sum256(data)
+	return hash[:]
+}
+
+func nodeHash(left, right []byte) []byte {
+	data := make([]byte, 0, 1+len(left)+len(right))
+	data = append(data, nodePrefix)
+	data = append(data, left...)
+	data = append(data, right...)
+
+	hash := sha256.Sum256(data)
+	return hash[:]
+}
+
+// Verify reports whether the leaf with the given proof hashes up to root.
+func Verify(root, leaf []byte, proof []Step) bool {
+	hash := leaf
+	for _, step := range proof {
+		if step.Left {
+			hash = nodeHash(step.Hash, hash)
+		} else {
+			hash = nodeHash(hash, step.Hash)
+		}
+	}
+
+	return bytes.Equal(hash, root)
+}
+
+// VerifyVote reports whether vote is included in the tally with root.
+func VerifyVote(root []byte, vote Vote, proof []Step) bool {
+	return Verify(root, LeafHash(vote), proof)
+}
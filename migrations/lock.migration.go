@@ -0,0 +1,148 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const lockId = "migrations"
+
+var (
+	ErrLockTimeout = errors.New("timed out waiting for the migration lock")
+	ErrLockLost    = errors.New("lost the migration lock while migrating")
+)
+
+// lock is a lease stored in MongoDB so that only one server instance runs
+// migrations at a time. The lease expires on its own if its owner dies.
+type lock struct {
+	coll  *mongo.Collection
+	owner string
+	ttl   time.Duration
+	wait  time.Duration
+}
+
+func newLock(db *mongo.Database) *lock {
+	hostname, _ := os.Hostname()
+
+	return &lock{
+		coll:  db.Collection("migration_locks"),
+		owner: fmt.Sprintf("%s-%d-%d", hostname, os.Getpid(), time.Now().UnixNano()),
+		ttl:   time.Minute,
+		wait:  5 * time.Minute,
+	}
+}
+
+func (l *lock) tryAcquire(ctx context.Context) (bool, error) {
+	now := time.Now()
+	filter := bson.M{
+		"_id": lockId,
+		"$or": bson.A{
+			bson.M{"lockedUntil": bson.M{"$lt": now}},
+			bson.M{"owner": l.owner},
+		},
+	}
+	update := bson.M{"$set": bson.M{"owner": l.owner, "lockedUntil": now.Add(l.ttl)}}
+
+	_, err := l.coll.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (l *lock) acquire(ctx context.Context) error {
+	deadline := time.Now().Add(l.wait)
+	for {
+		ok, err := l.tryAcquire(ctx)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return ErrLockTimeout
+		}
+
+		fmt.Println("Waiting for another instance to finish migrating...")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (l *lock) release(ctx context.Context) error {
+	_, err := l.coll.DeleteOne(ctx, bson.M{"_id": lockId, "owner": l.owner})
+	return err
+}
+
+// withLock runs fn while holding the lock, renewing the lease until fn
+// returns. If the lease is lost, or cannot be renewed before it runs out,
+// the context passed to fn is cancelled so that fn stops before another
+// instance takes over, and ErrLockLost is returned.
+func (l *lock) withLock(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := l.acquire(ctx); err != nil {
+		return err
+	}
+	defer l.release(context.Background())
+
+	leaseCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// lost is only read once the renewal goroutine has finished
+	lost := false
+	renewed := make(chan struct{})
+	go func() {
+		defer close(renewed)
+		ticker := time.NewTicker(l.ttl / 3)
+		defer ticker.Stop()
+
+		renewedAt := time.Now()
+		for {
+			select {
+			case <-leaseCtx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			ok, err := l.tryAcquire(leaseCtx)
+			if err == nil && ok {
+				renewedAt = time.Now()
+				continue
+			}
+			// a failed renewal is retried while the lease outlasts the next tick
+			if err == nil || time.Since(renewedAt) >= l.ttl-l.ttl/3 {
+				if leaseCtx.Err() == nil {
+					lost = true
+				}
+				cancel()
+				return
+			}
+		}
+	}()
+
+	err := fn(leaseCtx)
+	cancel()
+	<-renewed
+	if lost && err == nil {
+		return ErrLockLost
+	}
+	if lost {
+		return fmt.Errorf("%w: %v", ErrLockLost, err)
+	}
+
+	return err
+}
@@ -0,0 +1,43 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Migration is a single, ordered schema or data change. Up and Down must be
+// idempotent: a migration interrupted halfway is simply run again.
+// A nil Down means there is nothing to revert besides the applied record.
+type Migration struct {
+	Version     int64
+	Description string
+	Up          func(ctx context.Context, db *mongo.Database) error
+	Down        func(ctx context.Context, db *mongo.Database) error
+}
+
+// registry lists every migration in the order it must be applied.
+// New migrations are appended with the next version number.
+var registry = []Migration{
+	backfillVoteRate,
+	backfillTimestamps,
+	symbolSlugIndexes,
+	deletedAtIndex,
+	searchTextIndex,
+	voteSnapshotIndex,
+	voteEventsTTL,
+	rankingScores,
+	controversyScore,
+	webhookIndexes,
+	outboxIndexes,
+	changeStreamPreImages,
+	idempotencyTTL,
+	walletVotes,
+	tallyIndexes,
+	fraudIndexes,
+	spentChallengesTTL,
+	voteRateIndex,
+	webhookDeliverySource,
+	voteEventSegments,
+	tallyNodes,
+}
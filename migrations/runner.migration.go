@@ -0,0 +1,158 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type appliedRecord struct {
+	Version     int64     `bson:"_id"`
+	Description string    `bson:"description"`
+	AppliedAt   time.Time `bson:"appliedAt"`
+}
+
+type Status struct {
+	Version     int64
+	Description string
+	Applied     bool
+	AppliedAt   time.Time
+}
+
+type Runner struct {
+	db         *mongo.Database
+	applied    *mongo.Collection
+	lock       *lock
+	migrations []Migration
+}
+
+func NewRunner(db *mongo.Database) (*Runner, error) {
+	for i := 1; i < len(registry); i++ {
+		if registry[i].Version <= registry[i-1].Version {
+			return nil, fmt.Errorf("migration %d is registered after migration %d", registry[i].Version, registry[i-1].Version)
+		}
+	}
+
+	return &Runner{
+		db:         db,
+		applied:    db.Collection("schema_migrations"),
+		lock:       newLock(db),
+		migrations: registry,
+	}, nil
+}
+
+func cryptos(db *mongo.Database) *mongo.Collection {
+	return db.Collection(os.Getenv("DB_COLLECTION"))
+}
+
+func (r *Runner) appliedRecords(ctx context.Context) (map[int64]appliedRecord, error) {
+	cursor, err := r.applied.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	defer cursor.Close(ctx)
+
+	records := map[int64]appliedRecord{}
+	for cursor.Next(ctx) {
+		var record appliedRecord
+		if err := cursor.Decode(&record); err != nil {
+			return nil, err
+		}
+		records[record.Version] = record
+	}
+
+	return records, cursor.Err()
+}
+
+func (r *Runner) Status(ctx context.Context) ([]Status, error) {
+	records, err := r.appliedRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Status, 0, len(r.migrations))
+	for _, m := range r.migrations {
+		record, applied := records[m.Version]
+		result = append(result, Status{
+			Version:     m.Version,
+			Description: m.Description,
+			Applied:     applied,
+			AppliedAt:   record.AppliedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// Up applies every pending migration in order and returns how many ran.
+func (r *Runner) Up(ctx context.Context) (int, error) {
+	count := 0
+	err := r.lock.withLock(ctx, func(ctx context.Context) error {
+		records, err := r.appliedRecords(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range r.migrations {
+			if _, applied := records[m.Version]; applied {
+				continue
+			}
+
+			fmt.Printf("Applying migration %d: %s\n", m.Version, m.Description)
+			if err := m.Up(ctx, r.db); err != nil {
+				return fmt.Errorf("migration %d failed: %w", m.Version, err)
+			}
+
+			record := appliedRecord{Version: m.Version, Description: m.Description, AppliedAt: time.Now()}
+			_, err := r.applied.ReplaceOne(ctx, bson.M{"_id": m.Version}, record, options.Replace().SetUpsert(true))
+			if err != nil {
+				return fmt.Errorf("could not record migration %d: %w", m.Version, err)
+			}
+			count++
+		}
+
+		return nil
+	})
+
+	return count, err
+}
+
+// Down reverts the last steps applied migrations, newest first.
+func (r *Runner) Down(ctx context.Context, steps int) (int, error) {
+	count := 0
+	err := r.lock.withLock(ctx, func(ctx context.Context) error {
+		records, err := r.appliedRecords(ctx)
+		if err != nil {
+			return err
+		}
+
+		for i := len(r.migrations) - 1; i >= 0 && count < steps; i-- {
+			m := r.migrations[i]
+			if _, applied := records[m.Version]; !applied {
+				continue
+			}
+
+			fmt.Printf("Reverting migration %d: %s\n", m.Version, m.Description)
+			if m.Down != nil {
+				if err := m.Down(ctx, r.db); err != nil {
+					return fmt.Errorf("reverting migration %d failed: %w", m.Version, err)
+				}
+			}
+
+			if _, err := r.applied.DeleteOne(ctx, bson.M{"_id": m.Version}); err != nil {
+				return fmt.Errorf("could not unrecord migration %d: %w", m.Version, err)
+			}
+			count++
+		}
+
+		return nil
+	})
+
+	return count, err
+}
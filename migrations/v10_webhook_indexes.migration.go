@@ -0,0 +1,50 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var webhookIndexes = Migration{
+	Version:     10,
+	Description: "index webhooks by event and deliveries by status and webhook",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection("webhooks").Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "events", Value: 1}},
+			Options: options.Index().SetName("events"),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = db.Collection("webhook_deliveries").Indexes().CreateMany(ctx, []mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "status", Value: 1}, {Key: "nextAttemptAt", Value: 1}},
+				Options: options.Index().SetName("status_next_attempt"),
+			},
+			{
+				Keys:    bson.D{{Key: "webhookId", Value: 1}, {Key: "createdAt", Value: -1}},
+				Options: options.Index().SetName("webhook_created"),
+			},
+		})
+		return err
+	},
+	Down: func(ctx context.Context, db *mongo.Database) error {
+		drops := []struct{ collection, index string }{
+			{"webhooks", "events"},
+			{"webhook_deliveries", "status_next_attempt"},
+			{"webhook_deliveries", "webhook_created"},
+		}
+		for _, drop := range drops {
+			_, err := db.Collection(drop.collection).Indexes().DropOne(ctx, drop.index)
+			if err != nil && !isIndexNotFound(err) {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
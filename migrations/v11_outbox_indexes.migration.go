@@ -0,0 +1,44 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// relayed messages are kept for a week so consumers can be replayed by hand
+const outboxRetentionSeconds = 7 * 24 * 60 * 60
+
+var outboxIndexes = Migration{
+	Version:     11,
+	Description: "order outbox messages per key and expire relayed ones",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection("outbox").Indexes().CreateMany(ctx, []mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "key", Value: 1}, {Key: "seq", Value: 1}},
+				Options: options.Index().SetName("key_seq_unique").SetUnique(true),
+			},
+			{
+				Keys:    bson.D{{Key: "publishedAt", Value: 1}, {Key: "_id", Value: 1}},
+				Options: options.Index().SetName("published_id"),
+			},
+			{
+				Keys:    bson.D{{Key: "publishedAt", Value: 1}},
+				Options: options.Index().SetName("published_ttl").SetExpireAfterSeconds(outboxRetentionSeconds),
+			},
+		})
+		return err
+	},
+	Down: func(ctx context.Context, db *mongo.Database) error {
+		for _, name := range []string{"key_seq_unique", "published_id", "published_ttl"} {
+			_, err := db.Collection("outbox").Indexes().DropOne(ctx, name)
+			if err != nil && !isIndexNotFound(err) {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
@@ -0,0 +1,48 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// changeStreamPreImages lets the change feed tell by how much an update
+// changed the vote counts. Servers older than MongoDB 6.0 do not record
+// images; there the migration does nothing, so the ones after it still
+// apply, and the change feed cannot be used.
+var changeStreamPreImages = Migration{
+	Version:     12,
+	Description: "record pre-images of crypto changes for change streams",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		return setPreImages(ctx, db, true)
+	},
+	Down: func(ctx context.Context, db *mongo.Database) error {
+		return setPreImages(ctx, db, false)
+	},
+}
+
+func setPreImages(ctx context.Context, db *mongo.Database, enabled bool) error {
+	command := bson.D{
+		{Key: "collMod", Value: cryptos(db).Name()},
+		{Key: "changeStreamPreAndPostImages", Value: bson.M{"enabled": enabled}},
+	}
+
+	err := db.RunCommand(ctx, command).Err()
+	if isUnknownOption(err) {
+		fmt.Println("The server does not record change stream images, skipping")
+		return nil
+	}
+
+	return err
+}
+
+// isUnknownOption reports whether collMod refused an option the server
+// does not know of.
+func isUnknownOption(err error) bool {
+	cmdErr, ok := err.(mongo.CommandError)
+	// 40415 is the unknown field error of MongoDB 5.0, InvalidOptions the
+	// one of older versions
+	return ok && (cmdErr.Code == 40415 || cmdErr.Name == "InvalidOptions")
+}
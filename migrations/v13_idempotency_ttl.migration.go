@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var idempotencyTTL = Migration{
+	Version:     13,
+	Description: "expire idempotency keys once their window is over",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection("idempotency_keys").Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+			Options: options.Index().SetName("expires_ttl").SetExpireAfterSeconds(0),
+		})
+		return err
+	},
+	Down: func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection("idempotency_keys").Indexes().DropOne(ctx, "expires_ttl")
+		if err != nil && !isIndexNotFound(err) {
+			return err
+		}
+
+		return nil
+	},
+}
@@ -0,0 +1,50 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var walletVotes = Migration{
+	Version:     14,
+	Description: "count one vote per wallet and expire used vote nonces",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection("wallet_votes").Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "cryptoId", Value: 1}, {Key: "address", Value: 1}},
+			Options: options.Index().SetName("crypto_address_unique").SetUnique(true),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = db.Collection("vote_nonces").Indexes().CreateMany(ctx, []mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "address", Value: 1}, {Key: "nonce", Value: 1}},
+				Options: options.Index().SetName("address_nonce_unique").SetUnique(true),
+			},
+			{
+				Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+				Options: options.Index().SetName("expires_ttl").SetExpireAfterSeconds(0),
+			},
+		})
+		return err
+	},
+	Down: func(ctx context.Context, db *mongo.Database) error {
+		drops := []struct{ collection, index string }{
+			{"wallet_votes", "crypto_address_unique"},
+			{"vote_nonces", "address_nonce_unique"},
+			{"vote_nonces", "expires_ttl"},
+		}
+		for _, drop := range drops {
+			_, err := db.Collection(drop.collection).Indexes().DropOne(ctx, drop.index)
+			if err != nil && !isIndexNotFound(err) {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
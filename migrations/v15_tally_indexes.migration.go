@@ -0,0 +1,43 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var tallyIndexes = Migration{
+	Version:     15,
+	Description: "index tally leaves and the wallet votes changed since the last tally",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection("tally_leaves").Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "cryptoId", Value: 1}, {Key: "version", Value: 1}, {Key: "index", Value: 1}},
+			Options: options.Index().SetName("crypto_version_index_unique").SetUnique(true),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = db.Collection("wallet_votes").Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "updatedAt", Value: 1}},
+			Options: options.Index().SetName("updated_at"),
+		})
+		return err
+	},
+	Down: func(ctx context.Context, db *mongo.Database) error {
+		drops := []struct{ collection, index string }{
+			{"tally_leaves", "crypto_version_index_unique"},
+			{"wallet_votes", "updated_at"},
+		}
+		for _, drop := range drops {
+			_, err := db.Collection(drop.collection).Indexes().DropOne(ctx, drop.index)
+			if err != nil && !isIndexNotFound(err) {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
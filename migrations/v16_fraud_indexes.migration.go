@@ -0,0 +1,56 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var fraudIndexes = Migration{
+	Version:     16,
+	Description: "index fraud cases for review and wallets by first vote",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection("fraud_cases").Indexes().CreateMany(ctx, []mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "status", Value: 1}, {Key: "createdAt", Value: -1}},
+				Options: options.Index().SetName("status_created_at"),
+			},
+			{
+				Keys:    bson.D{{Key: "cryptoId", Value: 1}, {Key: "createdAt", Value: -1}},
+				Options: options.Index().SetName("crypto_created_at"),
+			},
+			{
+				Keys: bson.D{{Key: "quarantinedUntil", Value: 1}},
+				Options: options.Index().SetName("open_quarantines").
+					SetPartialFilterExpression(bson.M{"status": "open", "quarantinedUntil": bson.M{"$exists": true}}),
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = db.Collection("wallet_votes").Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "address", Value: 1}, {Key: "createdAt", Value: 1}},
+			Options: options.Index().SetName("address_created_at"),
+		})
+		return err
+	},
+	Down: func(ctx context.Context, db *mongo.Database) error {
+		drops := []struct{ collection, index string }{
+			{"fraud_cases", "status_created_at"},
+			{"fraud_cases", "crypto_created_at"},
+			{"fraud_cases", "open_quarantines"},
+			{"wallet_votes", "address_created_at"},
+		}
+		for _, drop := range drops {
+			_, err := db.Collection(drop.collection).Indexes().DropOne(ctx, drop.index)
+			if err != nil && !isIndexNotFound(err) {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
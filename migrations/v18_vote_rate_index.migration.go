@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"api/ranking"
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// voteRateIndex covers the default net score ranking, which migration 8 left
+// out of its score indexes.
+var voteRateIndex = Migration{
+	Version:     18,
+	Description: "index the net score ranking",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		_, err := cryptos(db).Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: ranking.NetField, Value: -1}},
+			Options: options.Index().SetName(ranking.NetField),
+		})
+		return err
+	},
+	Down: func(ctx context.Context, db *mongo.Database) error {
+		_, err := cryptos(db).Indexes().DropOne(ctx, ranking.NetField)
+		if err != nil && !isIndexNotFound(err) {
+			return err
+		}
+
+		return nil
+	},
+}
@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var webhookDeliverySource = Migration{
+	Version:     19,
+	Description: "queue webhook deliveries once per outbox message",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection("webhook_deliveries").Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "source", Value: 1}},
+			Options: options.Index().SetName("source_unique").SetUnique(true).SetSparse(true),
+		})
+		return err
+	},
+	Down: func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection("webhook_deliveries").Indexes().DropOne(ctx, "source_unique")
+		if err != nil && !isIndexNotFound(err) {
+			return err
+		}
+
+		return nil
+	},
+}
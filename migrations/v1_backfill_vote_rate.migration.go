@@ -0,0 +1,27 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var backfillVoteRate = Migration{
+	Version:     1,
+	Description: "recompute voteRate from likes and dislikes",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		pipeline := mongo.Pipeline{
+			{{Key: "$set", Value: bson.M{
+				"likes":    bson.M{"$ifNull": bson.A{"$likes", 0}},
+				"dislikes": bson.M{"$ifNull": bson.A{"$dislikes", 0}},
+			}}},
+			{{Key: "$set", Value: bson.M{
+				"voteRate": bson.M{"$subtract": bson.A{"$likes", "$dislikes"}},
+			}}},
+		}
+
+		_, err := cryptos(db).UpdateMany(ctx, bson.M{}, pipeline)
+		return err
+	},
+}
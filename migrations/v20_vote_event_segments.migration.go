@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// only flushed votes carry a node, so unbuffered votes stay out of the index
+var voteEventSegments = Migration{
+	Version:     20,
+	Description: "record the votes of a flushed segment once per crypto",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection("vote_events").Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys: bson.D{{Key: "node", Value: 1}, {Key: "seq", Value: 1}, {Key: "cryptoId", Value: 1}},
+			Options: options.Index().
+				SetName("node_seq_crypto_unique").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"node": bson.M{"$exists": true}}),
+		})
+		return err
+	},
+	Down: func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection("vote_events").Indexes().DropOne(ctx, "node_seq_crypto_unique")
+		if err != nil && !isIndexNotFound(err) {
+			return err
+		}
+
+		return nil
+	},
+}
@@ -0,0 +1,43 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var tallyNodes = Migration{
+	Version:     21,
+	Description: "index tally leaves by address and the stored nodes of tally trees",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection("tally_leaves").Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "cryptoId", Value: 1}, {Key: "version", Value: 1}, {Key: "address", Value: 1}},
+			Options: options.Index().SetName("crypto_version_address_unique").SetUnique(true),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = db.Collection("tally_nodes").Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "cryptoId", Value: 1}, {Key: "version", Value: 1}, {Key: "level", Value: 1}, {Key: "index", Value: 1}},
+			Options: options.Index().SetName("crypto_version_level_index_unique").SetUnique(true),
+		})
+		return err
+	},
+	Down: func(ctx context.Context, db *mongo.Database) error {
+		drops := []struct{ collection, index string }{
+			{"tally_leaves", "crypto_version_address_unique"},
+			{"tally_nodes", "crypto_version_level_index_unique"},
+		}
+		for _, drop := range drops {
+			_, err := db.Collection(drop.collection).Indexes().DropOne(ctx, drop.index)
+			if err != nil && !isIndexNotFound(err) {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var backfillTimestamps = Migration{
+	Version:     2,
+	Description: "add createdAt/updatedAt to documents missing them",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		filter := bson.M{"$or": bson.A{
+			bson.M{"createdAt": bson.M{"$exists": false}},
+			bson.M{"updatedAt": bson.M{"$exists": false}},
+		}}
+		pipeline := mongo.Pipeline{
+			{{Key: "$set", Value: bson.M{
+				"createdAt": bson.M{"$ifNull": bson.A{"$createdAt", bson.M{"$toDate": "$_id"}}},
+			}}},
+			{{Key: "$set", Value: bson.M{
+				"updatedAt": bson.M{"$ifNull": bson.A{"$updatedAt", "$createdAt"}},
+			}}},
+		}
+
+		_, err := cryptos(db).UpdateMany(ctx, filter, pipeline)
+		return err
+	},
+}
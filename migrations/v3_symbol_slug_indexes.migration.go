@@ -0,0 +1,139 @@
+package migrations
+
+import (
+	"api/models"
+	"api/utils"
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var nonAlphanumeric = regexp.MustCompile(`[^A-Z0-9]`)
+
+const (
+	maxSymbolLength = 10
+	maxSlugLength   = 64
+)
+
+// uniqueValue returns base, or base with the smallest numeric suffix that is
+// not taken yet, trimming base so the result stays within max characters.
+func uniqueValue(taken map[string]bool, base, separator string, max int) string {
+	trim := func(s string, n int) string {
+		if len(s) > n {
+			s = s[:n]
+		}
+		return strings.TrimRight(s, "-")
+	}
+
+	value := trim(base, max)
+	for n := 2; taken[value]; n++ {
+		suffix := separator + strconv.Itoa(n)
+		value = trim(base, max-len(suffix)) + suffix
+	}
+
+	taken[value] = true
+	return value
+}
+
+// takenValues loads every non-empty value of field already stored.
+func takenValues(ctx context.Context, coll *mongo.Collection, field string) (map[string]bool, error) {
+	values, err := coll.Distinct(ctx, field, bson.M{field: bson.M{"$nin": bson.A{nil, ""}}})
+	if err != nil {
+		return nil, err
+	}
+
+	taken := make(map[string]bool, len(values))
+	for _, value := range values {
+		if s, ok := value.(string); ok {
+			taken[s] = true
+		}
+	}
+
+	return taken, nil
+}
+
+var symbolSlugIndexes = Migration{
+	Version:     3,
+	Description: "backfill symbol/slug and make them unique",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		coll := cryptos(db)
+
+		symbols, err := takenValues(ctx, coll, "symbol")
+		if err != nil {
+			return err
+		}
+		slugs, err := takenValues(ctx, coll, "slug")
+		if err != nil {
+			return err
+		}
+
+		// Names that differ only in punctuation or case derive the same
+		// symbol and slug, and punctuation-only names derive nothing, so
+		// collisions get a numeric suffix and empty values a placeholder.
+		filter := bson.M{"$or": bson.A{
+			bson.M{"symbol": bson.M{"$in": bson.A{nil, ""}}},
+			bson.M{"slug": bson.M{"$in": bson.A{nil, ""}}},
+		}}
+		cursor, err := coll.Find(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		defer cursor.Close(ctx)
+
+		for cursor.Next(ctx) {
+			var data models.CryptoItem
+			if err := cursor.Decode(&data); err != nil {
+				return err
+			}
+
+			if data.Symbol == "" {
+				base := nonAlphanumeric.ReplaceAllString(utils.NormalizeSymbol(data.Name), "")
+				if base == "" {
+					base = "CRYPTO"
+				}
+				data.Symbol = uniqueValue(symbols, base, "", maxSymbolLength)
+			}
+			if data.Slug == "" {
+				base := utils.Slugify(data.Name)
+				if base == "" {
+					base = "crypto"
+				}
+				data.Slug = uniqueValue(slugs, base, "-", maxSlugLength)
+			}
+
+			update := bson.M{"$set": bson.M{"symbol": data.Symbol, "slug": data.Slug}}
+			if _, err := coll.UpdateByID(ctx, data.Id, update); err != nil {
+				return err
+			}
+		}
+		if err := cursor.Err(); err != nil {
+			return err
+		}
+
+		_, err = coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+			{Keys: bson.D{{Key: "symbol", Value: 1}}, Options: options.Index().SetName("symbol_unique").SetUnique(true)},
+			{Keys: bson.D{{Key: "slug", Value: 1}}, Options: options.Index().SetName("slug_unique").SetUnique(true)},
+		})
+		return err
+	},
+	Down: func(ctx context.Context, db *mongo.Database) error {
+		for _, name := range []string{"symbol_unique", "slug_unique"} {
+			if _, err := cryptos(db).Indexes().DropOne(ctx, name); err != nil && !isIndexNotFound(err) {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
+
+func isIndexNotFound(err error) bool {
+	cmdErr, ok := err.(mongo.CommandError)
+	return ok && cmdErr.Name == "IndexNotFound"
+}
@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var deletedAtIndex = Migration{
+	Version:     4,
+	Description: "index deletedAt for the trash and retention job",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		_, err := cryptos(db).Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "deletedAt", Value: 1}},
+			Options: options.Index().SetName("deletedAt").SetSparse(true),
+		})
+		return err
+	},
+	Down: func(ctx context.Context, db *mongo.Database) error {
+		_, err := cryptos(db).Indexes().DropOne(ctx, "deletedAt")
+		if err != nil && !isIndexNotFound(err) {
+			return err
+		}
+
+		return nil
+	},
+}
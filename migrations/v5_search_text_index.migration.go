@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var searchTextIndex = Migration{
+	Version:     5,
+	Description: "text index over name, symbol and description",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		_, err := cryptos(db).Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "symbol", Value: "text"},
+				{Key: "name", Value: "text"},
+				{Key: "description", Value: "text"},
+			},
+			Options: options.Index().
+				SetName("search_text").
+				SetWeights(bson.M{"symbol": 10, "name": 5, "description": 1}),
+		})
+		return err
+	},
+	Down: func(ctx context.Context, db *mongo.Database) error {
+		_, err := cryptos(db).Indexes().DropOne(ctx, "search_text")
+		if err != nil && !isIndexNotFound(err) {
+			return err
+		}
+
+		return nil
+	},
+}
@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var voteSnapshotIndex = Migration{
+	Version:     6,
+	Description: "unique index on vote snapshots per crypto and bucket",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection("vote_snapshots").Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "cryptoId", Value: 1}, {Key: "bucket", Value: 1}},
+			Options: options.Index().SetName("crypto_bucket_unique").SetUnique(true),
+		})
+		return err
+	},
+	Down: func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection("vote_snapshots").Indexes().DropOne(ctx, "crypto_bucket_unique")
+		if err != nil && !isIndexNotFound(err) {
+			return err
+		}
+
+		return nil
+	},
+}
@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// vote events only feed the trending windows, the longest being a week.
+var voteEventsTTL = Migration{
+	Version:     7,
+	Description: "expire vote events after eight days",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection("vote_events").Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "at", Value: 1}},
+			Options: options.Index().SetName("at_ttl").SetExpireAfterSeconds(int32((8 * 24 * time.Hour).Seconds())),
+		})
+		return err
+	},
+	Down: func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection("vote_events").Indexes().DropOne(ctx, "at_ttl")
+		if err != nil && !isIndexNotFound(err) {
+			return err
+		}
+
+		return nil
+	},
+}
@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"api/ranking"
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var scoreFields = []string{ranking.RatioField, ranking.WilsonField, ranking.BayesianField, ranking.HotField}
+
+var rankingScores = Migration{
+	Version:     8,
+	Description: "backfill and index the ranking scores",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		votedAt := bson.M{"$ifNull": bson.A{"$updatedAt", "$$NOW"}}
+		pipeline := mongo.Pipeline{{{Key: "$set", Value: bson.M{"scores": ranking.Expression(votedAt)}}}}
+		if _, err := cryptos(db).UpdateMany(ctx, bson.M{}, pipeline); err != nil {
+			return err
+		}
+
+		indexes := make([]mongo.IndexModel, len(scoreFields))
+		for i, field := range scoreFields {
+			indexes[i] = mongo.IndexModel{
+				Keys:    bson.D{{Key: field, Value: -1}},
+				Options: options.Index().SetName(field),
+			}
+		}
+
+		_, err := cryptos(db).Indexes().CreateMany(ctx, indexes)
+		return err
+	},
+	Down: func(ctx context.Context, db *mongo.Database) error {
+		for _, field := range scoreFields {
+			_, err := cryptos(db).Indexes().DropOne(ctx, field)
+			if err != nil && !isIndexNotFound(err) {
+				return err
+			}
+		}
+
+		_, err := cryptos(db).UpdateMany(ctx, bson.M{}, bson.M{"$unset": bson.M{"scores": ""}})
+		return err
+	},
+}
@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"api/ranking"
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var controversyScore = Migration{
+	Version:     9,
+	Description: "backfill and index the controversy score",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		votedAt := bson.M{"$ifNull": bson.A{"$updatedAt", "$$NOW"}}
+		pipeline := mongo.Pipeline{{{Key: "$set", Value: bson.M{"scores": ranking.Expression(votedAt)}}}}
+		if _, err := cryptos(db).UpdateMany(ctx, bson.M{}, pipeline); err != nil {
+			return err
+		}
+
+		_, err := cryptos(db).Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: ranking.ControversyField, Value: -1}},
+			Options: options.Index().SetName(ranking.ControversyField),
+		})
+		return err
+	},
+	Down: func(ctx context.Context, db *mongo.Database) error {
+		_, err := cryptos(db).Indexes().DropOne(ctx, ranking.ControversyField)
+		if err != nil && !isIndexNotFound(err) {
+			return err
+		}
+
+		_, err = cryptos(db).UpdateMany(ctx, bson.M{}, bson.M{"$unset": bson.M{ranking.ControversyField: ""}})
+		return err
+	},
+}
@@ -9,10 +9,27 @@ import (
 type CryptoItem struct {
 	Id          primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
 	Name        string             `bson:"name" json:"name"`
+	Symbol      string             `bson:"symbol" json:"symbol"`
+	Slug        string             `bson:"slug" json:"slug"`
 	Description string             `bson:"description" json:"description"`
 	Likes       int64              `bson:"likes" json:"likes"`
 	Dislikes    int64              `bson:"dislikes" json:"dislikes"`
 	VoteRate    int64              `bson:"voteRate" json:"voteRate"`
+	Scores      Scores             `bson:"scores" json:"scores"`
 	CreatedAt   time.Time          `bson:"createdAt" json:"createdAt"`
 	UpdatedAt   time.Time          `bson:"updatedAt" json:"updatedAt"`
+	DeletedAt   *time.Time         `bson:"deletedAt,omitempty" json:"deletedAt,omitempty"`
+	// VoteFlushes holds, per instance, the last buffered vote segment
+	// applied to the counts.
+	VoteFlushes map[string]int64 `bson:"voteFlushes,omitempty" json:"-"`
+}
+
+// Scores holds the precomputed ranking scores of a crypto, see the ranking
+// package for how each one is derived from the votes.
+type Scores struct {
+	Ratio       float64 `bson:"ratio" json:"ratio"`
+	Wilson      float64 `bson:"wilson" json:"wilson"`
+	Bayesian    float64 `bson:"bayesian" json:"bayesian"`
+	Hot         float64 `bson:"hot" json:"hot"`
+	Controversy float64 `bson:"controversy" json:"controversy"`
 }
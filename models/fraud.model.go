@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	FraudCaseOpen     = "open"
+	FraudCaseApproved = "approved"
+	FraudCaseRejected = "rejected"
+)
+
+// FraudCase is a vote the fraud detector found suspicious, waiting for an
+// admin to approve or reject it.
+type FraudCase struct {
+	Id       primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	CryptoId primitive.ObjectID `bson:"cryptoId" json:"cryptoId"`
+	// Likes and Dislikes are what the vote changes the counts by once
+	// approved, or changed them by if it was counted; Direction is the vote
+	// as cast, such as "like" or "remove dislike".
+	Likes     int64    `bson:"likes" json:"likes"`
+	Dislikes  int64    `bson:"dislikes" json:"dislikes"`
+	Direction string   `bson:"direction" json:"direction"`
+	Ip        string   `bson:"ip,omitempty" json:"ip,omitempty"`
+	Network   string   `bson:"network,omitempty" json:"network,omitempty"`
+	Asn       string   `bson:"asn,omitempty" json:"asn,omitempty"`
+	Voter     string   `bson:"voter,omitempty" json:"voter,omitempty"`
+	Score     float64  `bson:"score" json:"score"`
+	Reasons   []string `bson:"reasons" json:"reasons"`
+	Action    string   `bson:"action" json:"action"`
+	// Counted tells whether the vote was applied to the counts.
+	Counted bool   `bson:"counted" json:"counted"`
+	Status  string `bson:"status" json:"status"`
+	// Sources are quarantined until QuarantinedUntil when this vote
+	// started a quarantine.
+	Sources          []string   `bson:"sources,omitempty" json:"sources,omitempty"`
+	QuarantinedUntil *time.Time `bson:"quarantinedUntil,omitempty" json:"quarantinedUntil,omitempty"`
+	CreatedAt        time.Time  `bson:"createdAt" json:"createdAt"`
+	ReviewedAt       *time.Time `bson:"reviewedAt,omitempty" json:"reviewedAt,omitempty"`
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	IdempotencyPending = "pending"
+	IdempotencyDone    = "done"
+)
+
+// IdempotencyRecord remembers the outcome of a mutating request so a retry
+// with the same key is answered without running it again.
+type IdempotencyRecord struct {
+	// Id is the method and the client's key.
+	Id          string `bson:"_id" json:"id"`
+	RequestHash []byte `bson:"requestHash" json:"-"`
+	State       string `bson:"state" json:"state"`
+	// Response is the marshaled response message, or Status the marshaled
+	// google.rpc.Status when the request failed.
+	Response    []byte    `bson:"response,omitempty" json:"-"`
+	Status      []byte    `bson:"status,omitempty" json:"-"`
+	LockedUntil time.Time `bson:"lockedUntil" json:"lockedUntil"`
+	CreatedAt   time.Time `bson:"createdAt" json:"createdAt"`
+	ExpiresAt   time.Time `bson:"expiresAt" json:"expiresAt"`
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// OutboxMessage is a domain event stored in the same transaction as the
+// change it describes, waiting to be relayed. Seq orders the messages that
+// share a Key.
+type OutboxMessage struct {
+	Id            primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Key           string             `bson:"key" json:"key"`
+	Seq           int64              `bson:"seq" json:"seq"`
+	Type          string             `bson:"type" json:"type"`
+	CryptoId      string             `bson:"cryptoId,omitempty" json:"cryptoId,omitempty"`
+	Crypto        *CryptoItem        `bson:"crypto,omitempty" json:"crypto,omitempty"`
+	LikesDelta    int64              `bson:"likesDelta,omitempty" json:"likesDelta,omitempty"`
+	DislikesDelta int64              `bson:"dislikesDelta,omitempty" json:"dislikesDelta,omitempty"`
+	OccurredAt    time.Time          `bson:"occurredAt" json:"occurredAt"`
+	PublishedAt   *time.Time         `bson:"publishedAt,omitempty" json:"-"`
+	Attempts      int64              `bson:"attempts,omitempty" json:"-"`
+	LastError     string             `bson:"lastError,omitempty" json:"-"`
+}
@@ -0,0 +1,14 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// ResumeToken is the position a change stream consumer has read up to.
+type ResumeToken struct {
+	Name      string    `bson:"_id" json:"name"`
+	Token     bson.Raw  `bson:"token" json:"-"`
+	UpdatedAt time.Time `bson:"updatedAt" json:"updatedAt"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// VoteSnapshot holds the last known vote counts of a crypto within the time
+// bucket starting at Bucket.
+type VoteSnapshot struct {
+	Id       primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	CryptoId primitive.ObjectID `bson:"cryptoId" json:"cryptoId"`
+	Bucket   time.Time          `bson:"bucket" json:"bucket"`
+	Likes    int64              `bson:"likes" json:"likes"`
+	Dislikes int64              `bson:"dislikes" json:"dislikes"`
+	VoteRate int64              `bson:"voteRate" json:"voteRate"`
+}
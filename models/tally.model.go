@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// VoteTally is the published count of the wallet votes on a crypto, with the
+// root of the Merkle tree over them.
+type VoteTally struct {
+	CryptoId primitive.ObjectID `bson:"_id" json:"cryptoId"`
+	// Version tells the leaves of this tally apart from those of the
+	// previous one, which are kept until the next tally is published.
+	Version         int64     `bson:"version" json:"version"`
+	PreviousVersion int64     `bson:"previousVersion" json:"-"`
+	Root            []byte    `bson:"root" json:"root"`
+	Likes           int64     `bson:"likes" json:"likes"`
+	Dislikes        int64     `bson:"dislikes" json:"dislikes"`
+	Voters          int64     `bson:"voters" json:"voters"`
+	BuiltAt         time.Time `bson:"builtAt" json:"builtAt"`
+}
+
+// TallyLeaf is a wallet vote as counted in a tally, at its position in the
+// tree.
+type TallyLeaf struct {
+	CryptoId primitive.ObjectID `bson:"cryptoId" json:"cryptoId"`
+	Version  int64              `bson:"version" json:"version"`
+	Index    int64              `bson:"index" json:"index"`
+	Address  string             `bson:"address" json:"address"`
+	Liked    bool               `bson:"liked" json:"liked"`
+	Disliked bool               `bson:"disliked" json:"disliked"`
+}
+
+// TallyNode is the hash of a node of the tree of a tally, stored so proofs
+// are read rather than rebuilt. Level 0 holds the leaves.
+type TallyNode struct {
+	CryptoId primitive.ObjectID `bson:"cryptoId" json:"cryptoId"`
+	Version  int64              `bson:"version" json:"version"`
+	Level    int64              `bson:"level" json:"level"`
+	Index    int64              `bson:"index" json:"index"`
+	Hash     []byte             `bson:"hash" json:"hash"`
+}
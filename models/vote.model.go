@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// VoteEvent records by how much a single vote changed the counts of a crypto.
+type VoteEvent struct {
+	Id       primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	CryptoId primitive.ObjectID `bson:"cryptoId" json:"cryptoId"`
+	Likes    int64              `bson:"likes" json:"likes"`
+	Dislikes int64              `bson:"dislikes" json:"dislikes"`
+	At       time.Time          `bson:"at" json:"at"`
+	// Node and Seq name the vote buffer segment a flushed vote came from,
+	// so a segment flushed twice is only recorded once.
+	Node string `bson:"node,omitempty" json:"-"`
+	Seq  int64  `bson:"seq,omitempty" json:"-"`
+}
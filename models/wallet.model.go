@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// WalletVote is the vote a wallet cast on a crypto.
+type WalletVote struct {
+	Id       primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	CryptoId primitive.ObjectID `bson:"cryptoId" json:"cryptoId"`
+	Address  string             `bson:"address" json:"address"`
+	Scheme   string             `bson:"scheme" json:"scheme"`
+	Liked    bool               `bson:"liked" json:"liked"`
+	Disliked bool               `bson:"disliked" json:"disliked"`
+	// Held is set while the last vote waits for fraud review, which keeps
+	// the record out of the tally.
+	Held      bool      `bson:"held,omitempty" json:"held,omitempty"`
+	CreatedAt time.Time `bson:"createdAt" json:"createdAt"`
+	UpdatedAt time.Time `bson:"updatedAt" json:"updatedAt"`
+}
+
+// VoteNonce is a nonce a wallet already signed a vote with. It is kept until
+// the signed timestamp is too old to be accepted anyway.
+type VoteNonce struct {
+	Address   string    `bson:"address" json:"address"`
+	Nonce     string    `bson:"nonce" json:"nonce"`
+	ExpiresAt time.Time `bson:"expiresAt" json:"expiresAt"`
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Webhook struct {
+	Id     primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	Url    string             `bson:"url" json:"url"`
+	Secret string             `bson:"secret" json:"-"`
+	// Events lists the event types delivered to the endpoint.
+	Events []string `bson:"events" json:"events"`
+	// VoteRateThresholds trigger a vote threshold event when a vote moves
+	// the voteRate of a crypto across one of them, in either direction.
+	VoteRateThresholds []int64 `bson:"voteRateThresholds" json:"voteRateThresholds"`
+	// CryptoIds restricts deliveries to these cryptos when not empty.
+	CryptoIds []string  `bson:"cryptoIds" json:"cryptoIds"`
+	CreatedAt time.Time `bson:"createdAt" json:"createdAt"`
+}
+
+const (
+	DeliveryPending   = "pending"
+	DeliveryDelivered = "delivered"
+	DeliveryDead      = "dead"
+)
+
+// WebhookDelivery is one event to send to one webhook, kept as a log once
+// delivered or given up on (dead).
+type WebhookDelivery struct {
+	Id            primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	WebhookId     primitive.ObjectID `bson:"webhookId" json:"webhookId"`
+	Event         string             `bson:"event" json:"event"`
+	Payload       string             `bson:"payload" json:"payload"`
+	Status        string             `bson:"status" json:"status"`
+	Attempts      int64              `bson:"attempts" json:"attempts"`
+	NextAttemptAt time.Time          `bson:"nextAttemptAt" json:"nextAttemptAt"`
+	LockedUntil   *time.Time         `bson:"lockedUntil,omitempty" json:"-"`
+	LastError     string             `bson:"lastError,omitempty" json:"lastError,omitempty"`
+	LastStatus    int64              `bson:"lastStatus,omitempty" json:"lastStatus,omitempty"`
+	CreatedAt     time.Time          `bson:"createdAt" json:"createdAt"`
+	DeliveredAt   *time.Time         `bson:"deliveredAt,omitempty" json:"deliveredAt,omitempty"`
+	// Source identifies the outbox message, webhook and event the delivery
+	// was queued for, so a message relayed twice is only delivered once.
+	Source string `bson:"source,omitempty" json:"-"`
+}
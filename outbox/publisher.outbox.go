@@ -0,0 +1,183 @@
+package outbox
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LogPublisher appends each message as a line of JSON to a file.
+type LogPublisher struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func NewLogPublisher(path string) (*LogPublisher, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogPublisher{file: file}, nil
+}
+
+func (p *LogPublisher) Publish(ctx context.Context, key string, payload []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.file.Write(append(payload, '\n')); err != nil {
+		return err
+	}
+
+	return p.file.Sync()
+}
+
+func (p *LogPublisher) Close() error {
+	return p.file.Close()
+}
+
+// NATSPublisher publishes to "<subject>.<key>" using the NATS text protocol.
+// The connection is opened on first use and re-opened after an error.
+type NATSPublisher struct {
+	Addr    string
+	Subject string
+	Timeout time.Duration
+
+	mu   sync.Mutex
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func (p *NATSPublisher) connect() error {
+	conn, err := net.DialTimeout("tcp", p.Addr, p.Timeout)
+	if err != nil {
+		return err
+	}
+
+	r := bufio.NewReader(conn)
+	conn.SetDeadline(time.Now().Add(p.Timeout))
+	// the server greets with INFO; verbose mode makes it acknowledge each PUB
+	if _, err := r.ReadString('\n'); err != nil {
+		conn.Close()
+		return err
+	}
+	if _, err := io.WriteString(conn, "CONNECT {\"verbose\":true,\"pedantic\":false,\"name\":\"outbox-relay\"}\r\n"); err != nil {
+		conn.Close()
+		return err
+	}
+	if err := expectOK(conn, r); err != nil {
+		conn.Close()
+		return err
+	}
+
+	p.conn, p.r = conn, r
+	return nil
+}
+
+// expectOK waits for the acknowledgement of the last command, answering the
+// server's keep-alive pings in between.
+func expectOK(conn net.Conn, r *bufio.Reader) error {
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return err
+		}
+
+		switch line = strings.TrimSpace(line); {
+		case line == "+OK":
+			return nil
+		case line == "PING":
+			if _, err := io.WriteString(conn, "PONG\r\n"); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("nats: %s", line)
+		}
+	}
+}
+
+func (p *NATSPublisher) Publish(ctx context.Context, key string, payload []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conn == nil {
+		if err := p.connect(); err != nil {
+			return err
+		}
+	}
+
+	p.conn.SetDeadline(time.Now().Add(p.Timeout))
+	_, err := fmt.Fprintf(p.conn, "PUB %s.%s %d\r\n%s\r\n", p.Subject, key, len(payload), payload)
+	if err == nil {
+		err = expectOK(p.conn, p.r)
+	}
+	if err != nil {
+		p.conn.Close()
+		p.conn = nil
+	}
+
+	return err
+}
+
+func (p *NATSPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conn == nil {
+		return nil
+	}
+
+	err := p.conn.Close()
+	p.conn = nil
+	return err
+}
+
+// KafkaRESTPublisher produces to a Kafka topic through a Confluent REST
+// Proxy, keyed by crypto id so each crypto's messages share a partition.
+type KafkaRESTPublisher struct {
+	Url    string
+	Topic  string
+	Client *http.Client
+}
+
+func (p *KafkaRESTPublisher) Publish(ctx context.Context, key string, payload []byte) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"records": []map[string]interface{}{{"key": key, "value": json.RawMessage(payload)}},
+	})
+	if err != nil {
+		return err
+	}
+
+	url := strings.TrimRight(p.Url, "/") + "/topics/" + p.Topic
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("kafka rest proxy answered %s: %s", resp.Status, bytes.TrimSpace(detail))
+	}
+
+	return nil
+}
+
+func (p *KafkaRESTPublisher) Close() error {
+	return nil
+}
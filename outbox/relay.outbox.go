@@ -0,0 +1,152 @@
+package outbox
+
+import (
+	"api/models"
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Publisher delivers relayed messages outside the process. key is the
+// crypto id (or CatalogKey), suitable as a partition or routing key.
+type Publisher interface {
+	Publish(ctx context.Context, key string, payload []byte) error
+	Close() error
+}
+
+// Publishers publishes each message to every publisher in turn. A failure
+// stops there, so the relay retries the message on all of them.
+type Publishers []Publisher
+
+func (p Publishers) Publish(ctx context.Context, key string, payload []byte) error {
+	for _, publisher := range p {
+		if err := publisher.Publish(ctx, key, payload); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p Publishers) Close() error {
+	var first error
+	for _, publisher := range p {
+		if err := publisher.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+
+	return first
+}
+
+// Relay publishes outbox messages at least once, in Seq order per key: a
+// message waits while an earlier message of its key is unpublished. Relays
+// on several instances may publish a message twice but never out of order.
+type Relay struct {
+	Messages     *mongo.Collection
+	Publisher    Publisher
+	BatchSize    int64
+	PollInterval time.Duration
+}
+
+func NewRelay(db *mongo.Database, publisher Publisher, batchSize int64, pollInterval time.Duration) *Relay {
+	return &Relay{
+		Messages:     db.Collection("outbox"),
+		Publisher:    publisher,
+		BatchSize:    batchSize,
+		PollInterval: pollInterval,
+	}
+}
+
+// blocked reports whether an earlier message of the same key is unpublished.
+func (r *Relay) blocked(ctx context.Context, message models.OutboxMessage) (bool, error) {
+	filter := bson.M{"key": message.Key, "seq": bson.M{"$lt": message.Seq}, "publishedAt": nil}
+	count, err := r.Messages.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	return count > 0, err
+}
+
+func (r *Relay) publish(ctx context.Context, message models.OutboxMessage) error {
+	payload, err := json.Marshal(message)
+	if err == nil {
+		err = r.Publisher.Publish(ctx, message.Key, payload)
+	}
+
+	if err != nil {
+		update := bson.M{"$inc": bson.M{"attempts": 1}, "$set": bson.M{"lastError": err.Error()}}
+		if _, updateErr := r.Messages.UpdateOne(ctx, bson.M{"_id": message.Id}, update); updateErr != nil {
+			log.Printf("Could not record outbox failure for %s: %v", message.Id.Hex(), updateErr)
+		}
+		return err
+	}
+
+	_, err = r.Messages.UpdateOne(ctx, bson.M{"_id": message.Id}, bson.M{"$set": bson.M{"publishedAt": time.Now()}})
+	return err
+}
+
+// drain publishes batches of pending messages until none can make progress.
+func (r *Relay) drain(ctx context.Context) error {
+	opts := options.Find().SetSort(bson.M{"_id": 1}).SetLimit(r.BatchSize)
+
+	for {
+		cursor, err := r.Messages.Find(ctx, bson.M{"publishedAt": nil}, opts)
+		if err != nil {
+			return err
+		}
+
+		var batch []models.OutboxMessage
+		if err := cursor.All(ctx, &batch); err != nil {
+			return err
+		}
+
+		published := 0
+		stalled := map[string]bool{}
+		for _, message := range batch {
+			if stalled[message.Key] {
+				continue
+			}
+
+			waiting, err := r.blocked(ctx, message)
+			if err != nil {
+				return err
+			}
+			if waiting {
+				stalled[message.Key] = true
+				continue
+			}
+
+			if err := r.publish(ctx, message); err != nil {
+				log.Printf("Could not relay outbox message %s: %v", message.Id.Hex(), err)
+				stalled[message.Key] = true
+				continue
+			}
+			published++
+		}
+
+		if published == 0 {
+			return nil
+		}
+	}
+}
+
+// Run relays pending messages every PollInterval until ctx is cancelled.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.drain(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Could not relay the outbox: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package outbox
+
+import (
+	"api/events"
+	"api/models"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CatalogKey orders the events that are not about a single crypto.
+const CatalogKey = "catalog"
+
+// Store writes events to the outbox. Enqueue must run in the transaction of
+// the change the events describe.
+type Store struct {
+	messages  *mongo.Collection
+	sequences *mongo.Collection
+}
+
+func NewStore(db *mongo.Database) *Store {
+	return &Store{
+		messages:  db.Collection("outbox"),
+		sequences: db.Collection("outbox_sequences"),
+	}
+}
+
+func keyOf(event events.Event) string {
+	if event.CryptoId == "" {
+		return CatalogKey
+	}
+
+	return event.CryptoId
+}
+
+// next increments the sequence of key. Two transactions writing events of
+// the same key conflict here, so their sequence numbers follow commit order.
+func (s *Store) next(ctx context.Context, key string) (int64, error) {
+	var sequence struct {
+		Seq int64 `bson:"seq"`
+	}
+
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	err := s.sequences.FindOneAndUpdate(ctx, bson.M{"_id": key}, bson.M{"$inc": bson.M{"seq": 1}}, opts).Decode(&sequence)
+	return sequence.Seq, err
+}
+
+func (s *Store) Enqueue(ctx context.Context, pending ...events.Event) error {
+	if s == nil || len(pending) == 0 {
+		return nil
+	}
+
+	messages := make([]interface{}, len(pending))
+	for i, event := range pending {
+		key := keyOf(event)
+		seq, err := s.next(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		message := models.OutboxMessage{
+			Key:           key,
+			Seq:           seq,
+			Type:          string(event.Type),
+			CryptoId:      event.CryptoId,
+			LikesDelta:    event.LikesDelta,
+			DislikesDelta: event.DislikesDelta,
+			OccurredAt:    event.OccurredAt,
+		}
+		if message.OccurredAt.IsZero() {
+			message.OccurredAt = time.Now()
+		}
+		if event.Type != events.Deleted && event.Type != events.Purged && event.Type != events.Imported {
+			crypto := event.Crypto
+			message.Crypto = &crypto
+		}
+		messages[i] = message
+	}
+
+	_, err := s.messages.InsertMany(ctx, messages)
+	return err
+}
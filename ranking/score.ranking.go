@@ -0,0 +1,120 @@
+package ranking
+
+import (
+	"api/models"
+	"math"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// z is the 95% confidence quantile used by the Wilson lower bound.
+	z = 1.96
+	// priorMean and priorWeight pull the Bayesian average of cryptos with
+	// few votes towards an even split, as if each had ten extra votes.
+	priorMean   = 0.5
+	priorWeight = 10.0
+	// in the hot score, a vote cast 12.5 hours later weighs as much as ten
+	// times the net votes.
+	hotHalfLife = 12.5 * float64(time.Hour/time.Millisecond)
+)
+
+// hotEpoch keeps the time term of the hot score small.
+var hotEpoch = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Document fields holding the score of each ranking strategy.
+const (
+	NetField         = "voteRate"
+	RatioField       = "scores.ratio"
+	WilsonField      = "scores.wilson"
+	BayesianField    = "scores.bayesian"
+	HotField         = "scores.hot"
+	ControversyField = "scores.controversy"
+)
+
+// Compute derives the scores of a crypto, votedAt being the time of its last
+// vote. It must agree with Expression.
+func Compute(likes, dislikes int64, votedAt time.Time) models.Scores {
+	l, d := float64(likes), float64(dislikes)
+	n := l + d
+
+	var scores models.Scores
+	if n > 0 {
+		p := l / n
+		scores.Ratio = p
+		scores.Wilson = (p + z*z/(2*n) - z*math.Sqrt((p*(1-p)+z*z/(4*n))/n)) / (1 + z*z/n)
+	}
+	scores.Bayesian = (priorWeight*priorMean + l) / (priorWeight + n)
+	// controversy is the number of votes raised to the balance between
+	// likes and dislikes: many, evenly split votes score highest.
+	if likes > 0 && dislikes > 0 {
+		scores.Controversy = math.Pow(n, math.Min(l, d)/math.Max(l, d))
+	}
+
+	var sign float64
+	switch {
+	case likes > dislikes:
+		sign = 1
+	case likes < dislikes:
+		sign = -1
+	}
+	net := math.Abs(l - d)
+	scores.Hot = sign*math.Log10(math.Max(1, net)) + float64(votedAt.Sub(hotEpoch)/time.Millisecond)/hotHalfLife
+
+	return scores
+}
+
+// Expression computes the same scores as Compute inside an update pipeline,
+// from the likes and dislikes fields and the votedAt date expression.
+func Expression(votedAt interface{}) bson.M {
+	wilson := bson.M{"$let": bson.M{
+		"vars": bson.M{"p": bson.M{"$divide": bson.A{"$$l", "$$n"}}},
+		"in": bson.M{"$divide": bson.A{
+			bson.M{"$subtract": bson.A{
+				bson.M{"$add": bson.A{"$$p", bson.M{"$divide": bson.A{z * z, bson.M{"$multiply": bson.A{2, "$$n"}}}}}},
+				bson.M{"$multiply": bson.A{z, bson.M{"$sqrt": bson.M{"$divide": bson.A{
+					bson.M{"$add": bson.A{
+						bson.M{"$multiply": bson.A{"$$p", bson.M{"$subtract": bson.A{1, "$$p"}}}},
+						bson.M{"$divide": bson.A{z * z, bson.M{"$multiply": bson.A{4, "$$n"}}}},
+					}},
+					"$$n",
+				}}}}},
+			}},
+			bson.M{"$add": bson.A{1, bson.M{"$divide": bson.A{z * z, "$$n"}}}},
+		}},
+	}}
+
+	age := bson.M{"$subtract": bson.A{votedAt, hotEpoch}}
+	hot := bson.M{"$add": bson.A{
+		bson.M{"$multiply": bson.A{
+			bson.M{"$cmp": bson.A{"$$l", "$$d"}},
+			bson.M{"$log10": bson.M{"$max": bson.A{1, bson.M{"$abs": bson.M{"$subtract": bson.A{"$$l", "$$d"}}}}}},
+		}},
+		bson.M{"$divide": bson.A{age, hotHalfLife}},
+	}}
+
+	return bson.M{"$let": bson.M{
+		"vars": bson.M{
+			"l": bson.M{"$ifNull": bson.A{"$likes", 0}},
+			"d": bson.M{"$ifNull": bson.A{"$dislikes", 0}},
+			"n": bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$likes", 0}}, bson.M{"$ifNull": bson.A{"$dislikes", 0}}}},
+		},
+		"in": bson.M{
+			"ratio":    bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{"$$n", 0}}, 0.0, bson.M{"$divide": bson.A{"$$l", "$$n"}}}},
+			"wilson":   bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{"$$n", 0}}, 0.0, wilson}},
+			"bayesian": bson.M{"$divide": bson.A{bson.M{"$add": bson.A{priorWeight * priorMean, "$$l"}}, bson.M{"$add": bson.A{priorWeight, "$$n"}}}},
+			"hot":      hot,
+			"controversy": bson.M{"$cond": bson.A{
+				bson.M{"$or": bson.A{bson.M{"$eq": bson.A{"$$l", 0}}, bson.M{"$eq": bson.A{"$$d", 0}}}},
+				0.0,
+				bson.M{"$pow": bson.A{"$$n", bson.M{"$divide": bson.A{bson.M{"$min": bson.A{"$$l", "$$d"}}, bson.M{"$max": bson.A{"$$l", "$$d"}}}}}},
+			}},
+		},
+	}}
+}
+
+// Stage is the update pipeline stage refreshing the scores after a vote.
+func Stage() bson.D {
+	return bson.D{{Key: "$set", Value: bson.M{"scores": Expression("$$NOW")}}}
+}
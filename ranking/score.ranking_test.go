@@ -0,0 +1,201 @@
+package ranking
+
+import (
+	"api/models"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// scoreCases is the fixture both Compute and Expression are checked against;
+// the scores were worked out by hand from the formulas.
+var scoreCases = []struct {
+	likes, dislikes int64
+	votedAt         time.Time
+	want            models.Scores
+}{
+	{0, 0, hotEpoch, models.Scores{Ratio: 0, Wilson: 0, Bayesian: 0.5, Hot: 0, Controversy: 0}},
+	{10, 0, hotEpoch, models.Scores{Ratio: 1, Wilson: 0.7224598312333834, Bayesian: 0.75, Hot: 1, Controversy: 0}},
+	{0, 3, hotEpoch.Add(25 * time.Hour), models.Scores{Ratio: 0, Wilson: 0, Bayesian: 0.38461538461538464, Hot: 1.5228787452803376, Controversy: 0}},
+	{5, 5, hotEpoch.Add(12*time.Hour + 30*time.Minute), models.Scores{Ratio: 0.5, Wilson: 0.2365895936154873, Bayesian: 0.5, Hot: 1, Controversy: 10}},
+	{3, 7, hotEpoch.Add(12*time.Hour + 30*time.Minute), models.Scores{Ratio: 0.3, Wilson: 0.10778928748621182, Bayesian: 0.4, Hot: 0.3979400086720376, Controversy: 2.6826957952797255}},
+	{100, 50, hotEpoch.Add(-25 * time.Hour), models.Scores{Ratio: 0.6666666666666666, Wilson: 0.5878960768592671, Bayesian: 0.65625, Hot: -0.30102999566398125, Controversy: 12.24744871391589}},
+	{1, 1000, hotEpoch, models.Scores{Ratio: 0.000999000999000999, Wilson: 0.00017636544181700588, Bayesian: 0.005934718100890208, Hot: -2.9995654882259823, Controversy: 1.0069326752808456}},
+}
+
+func fields(scores models.Scores) map[string]float64 {
+	return map[string]float64{"ratio": scores.Ratio, "wilson": scores.Wilson, "bayesian": scores.Bayesian, "hot": scores.Hot, "controversy": scores.Controversy}
+}
+
+func checkScores(t *testing.T, name string, got map[string]float64, want models.Scores) {
+	t.Helper()
+	for field, value := range fields(want) {
+		if math.Abs(got[field]-value) > 1e-9 {
+			t.Errorf("%s: %s is %v, want %v", name, field, got[field], value)
+		}
+	}
+}
+
+func TestCompute(t *testing.T) {
+	for _, c := range scoreCases {
+		got := Compute(c.likes, c.dislikes, c.votedAt)
+		checkScores(t, fmt.Sprintf("Compute(%d, %d)", c.likes, c.dislikes), fields(got), c.want)
+	}
+}
+
+func TestExpression(t *testing.T) {
+	for _, c := range scoreCases {
+		doc := map[string]interface{}{"likes": float64(c.likes), "dislikes": float64(c.dislikes)}
+		scores, ok := evaluate(t, Expression(c.votedAt), doc, nil).(map[string]interface{})
+		if !ok {
+			t.Fatalf("Expression did not evaluate to a document")
+		}
+
+		got := map[string]float64{}
+		for field, value := range scores {
+			got[field] = value.(float64)
+		}
+		checkScores(t, fmt.Sprintf("Expression(%d, %d)", c.likes, c.dislikes), got, c.want)
+	}
+}
+
+// evaluate runs the aggregation operators Expression uses against doc, the
+// way the server would. Numbers are float64 and dates subtract to
+// milliseconds.
+func evaluate(t *testing.T, expr interface{}, doc, vars map[string]interface{}) interface{} {
+	t.Helper()
+	switch e := expr.(type) {
+	case int:
+		return float64(e)
+	case float64, time.Time:
+		return e
+	case string:
+		switch {
+		case len(e) > 2 && e[:2] == "$$":
+			value, ok := vars[e[2:]]
+			if !ok {
+				t.Fatalf("undefined variable %s", e)
+			}
+			return value
+		case len(e) > 1 && e[0] == '$':
+			return doc[e[1:]]
+		}
+		return e
+	case bson.M:
+		if len(e) != 1 {
+			// a document of fields, such as the scores
+			result := map[string]interface{}{}
+			for field, value := range e {
+				result[field] = evaluate(t, value, doc, vars)
+			}
+			return result
+		}
+		for op, arg := range e {
+			return operator(t, op, arg, doc, vars)
+		}
+	}
+
+	t.Fatalf("cannot evaluate %T %v", expr, expr)
+	return nil
+}
+
+func operator(t *testing.T, op string, arg interface{}, doc, vars map[string]interface{}) interface{} {
+	t.Helper()
+	if op == "$let" {
+		spec := arg.(bson.M)
+		scope := map[string]interface{}{}
+		for name, value := range vars {
+			scope[name] = value
+		}
+		for name, value := range spec["vars"].(bson.M) {
+			scope[name] = evaluate(t, value, doc, vars)
+		}
+		return evaluate(t, spec["in"], doc, scope)
+	}
+	if op[0] != '$' {
+		return map[string]interface{}{op: evaluate(t, arg, doc, vars)}
+	}
+
+	var args []interface{}
+	if list, ok := arg.(bson.A); ok {
+		for _, item := range list {
+			args = append(args, evaluate(t, item, doc, vars))
+		}
+	} else {
+		args = []interface{}{evaluate(t, arg, doc, vars)}
+	}
+	number := func(i int) float64 {
+		switch value := args[i].(type) {
+		case float64:
+			return value
+		case time.Time:
+			return float64(value.UnixMilli())
+		}
+		t.Fatalf("%s: argument %d is %T, not a number", op, i, args[i])
+		return 0
+	}
+
+	switch op {
+	case "$ifNull":
+		if args[0] != nil {
+			return args[0]
+		}
+		return args[1]
+	case "$cond":
+		if args[0].(bool) {
+			return args[1]
+		}
+		return args[2]
+	case "$eq":
+		return number(0) == number(1)
+	case "$or":
+		for _, value := range args {
+			if value.(bool) {
+				return true
+			}
+		}
+		return false
+	case "$cmp":
+		switch a, b := number(0), number(1); {
+		case a < b:
+			return -1.0
+		case a > b:
+			return 1.0
+		}
+		return 0.0
+	case "$add":
+		sum := 0.0
+		for i := range args {
+			sum += number(i)
+		}
+		return sum
+	case "$multiply":
+		product := 1.0
+		for i := range args {
+			product *= number(i)
+		}
+		return product
+	case "$subtract":
+		return number(0) - number(1)
+	case "$divide":
+		return number(0) / number(1)
+	case "$max":
+		return math.Max(number(0), number(1))
+	case "$min":
+		return math.Min(number(0), number(1))
+	case "$abs":
+		return math.Abs(number(0))
+	case "$sqrt":
+		return math.Sqrt(number(0))
+	case "$log10":
+		return math.Log10(number(0))
+	case "$pow":
+		return math.Pow(number(0), number(1))
+	}
+
+	t.Fatalf("unsupported operator %s", op)
+	return nil
+}
@@ -0,0 +1,184 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Range is a half-open span of rune offsets inside a field's text.
+type Range struct {
+	Start int
+	End   int
+}
+
+type Field struct {
+	Name   string
+	Text   string
+	Weight float64
+}
+
+type Highlight struct {
+	Field  string
+	Text   string
+	Ranges []Range
+}
+
+type token struct {
+	text       string
+	start, end int
+}
+
+// Terms splits a query into lowercase alphanumeric terms.
+func Terms(query string) []string {
+	var terms []string
+	for _, t := range tokenize(query) {
+		terms = append(terms, t.text)
+	}
+
+	return terms
+}
+
+func tokenize(text string) []token {
+	var tokens []token
+	var current []rune
+	start := 0
+	i := 0
+	for _, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if len(current) == 0 {
+				start = i
+			}
+			current = append(current, unicode.ToLower(r))
+		} else if len(current) > 0 {
+			tokens = append(tokens, token{text: string(current), start: start, end: i})
+			current = current[:0]
+		}
+		i++
+	}
+	if len(current) > 0 {
+		tokens = append(tokens, token{text: string(current), start: start, end: i})
+	}
+
+	return tokens
+}
+
+// Distance is the optimal string alignment distance between a and b:
+// insertions, deletions, substitutions and adjacent transpositions cost 1.
+func Distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(minInt(prev[j]+1, curr[j-1]+1), prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				curr[j] = minInt(curr[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+
+	return prev[len(rb)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+// maxEdits is how many typos a term of that length tolerates.
+func maxEdits(term string) int {
+	switch n := len([]rune(term)); {
+	case n <= 2:
+		return 0
+	case n <= 5:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// similarity rates how well term matches a single token, from 0 (no match) to 1 (exact).
+func similarity(term, tok string, fuzzy bool) float64 {
+	switch {
+	case tok == term:
+		return 1
+	case strings.HasPrefix(tok, term):
+		return 0.75
+	case !fuzzy:
+		return 0
+	}
+
+	edits := maxEdits(term)
+	if edits == 0 {
+		return 0
+	}
+
+	d := Distance(term, tok)
+	// also forgive typos in what looks like a prefix of a longer token
+	if runes := []rune(tok); len(runes) > len([]rune(term)) {
+		d = minInt(d, Distance(term, string(runes[:len([]rune(term))])))
+	}
+	if d > edits {
+		return 0
+	}
+
+	return 0.5 * (1 - float64(d)/float64(len([]rune(term))+1))
+}
+
+// Score rates fields against terms. Every term must match some token for
+// ok to be true; the score sums the best match of each term times its field
+// weight, and the highlights list every matching token per field.
+func Score(fields []Field, terms []string, fuzzy bool) (score float64, highlights []Highlight, ok bool) {
+	if len(terms) == 0 {
+		return 0, nil, false
+	}
+
+	best := make([]float64, len(terms))
+	for _, field := range fields {
+		var ranges []Range
+		for _, tok := range tokenize(field.Text) {
+			hit := false
+			for i, term := range terms {
+				sim := similarity(term, tok.text, fuzzy)
+				if sim == 0 {
+					continue
+				}
+				hit = true
+				if sim*field.Weight > best[i] {
+					best[i] = sim * field.Weight
+				}
+			}
+			if hit {
+				ranges = append(ranges, Range{Start: tok.start, End: tok.end})
+			}
+		}
+
+		if len(ranges) > 0 {
+			highlights = append(highlights, Highlight{Field: field.Name, Text: field.Text, Ranges: ranges})
+		}
+	}
+
+	ok = true
+	for _, b := range best {
+		if b == 0 {
+			ok = false
+		}
+		score += b
+	}
+
+	return score, highlights, ok
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"api/catalog"
+	"api/config"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+)
+
+const catalogUsage = "usage: catalog export [-format csv|json|ndjson] [-out file] [-deleted] | import [-format csv|json|ndjson] [-in file]"
+
+func runCatalog(args []string) {
+	defer cryptoDb.Database().Client().Disconnect(mongoCtx)
+
+	if len(args) == 0 {
+		log.Fatal(catalogUsage)
+	}
+
+	flags := flag.NewFlagSet("catalog "+args[0], flag.ExitOnError)
+	format := flags.String("format", catalog.FormatNDJSON, "file format: csv, json or ndjson")
+
+	switch args[0] {
+	case "export":
+		out := flags.String("out", "", "output file (default stdout)")
+		deleted := flags.Bool("deleted", false, "include cryptos in the trash")
+		flags.Parse(args[1:])
+
+		var w io.Writer = os.Stdout
+		if *out != "" {
+			file, err := os.Create(*out)
+			if err != nil {
+				log.Fatalf("Could not create %s: %s", *out, err.Error())
+			}
+			defer file.Close()
+			w = file
+		}
+
+		writer, err := catalog.NewWriter(*format, w)
+		if err != nil {
+			log.Fatalf("Could not export: %s", err.Error())
+		}
+
+		count, err := catalog.Export(mongoCtx, cryptoDb, writer, *deleted)
+		if err == nil {
+			err = writer.Close()
+		}
+		if err != nil {
+			log.Fatalf("Could not export: %s", err.Error())
+		}
+		fmt.Fprintf(os.Stderr, "Exported %d crypto(s)\n", count)
+	case "import":
+		in := flags.String("in", "", "input file (default stdin)")
+		flags.Parse(args[1:])
+
+		var r io.Reader = os.Stdin
+		if *in != "" {
+			file, err := os.Open(*in)
+			if err != nil {
+				log.Fatalf("Could not open %s: %s", *in, err.Error())
+			}
+			defer file.Close()
+			r = file
+		}
+
+		reader, err := catalog.NewReader(*format, r)
+		if err != nil {
+			log.Fatalf("Could not import: %s", err.Error())
+		}
+
+		summary, err := catalog.Restore(mongoCtx, cryptoDb, reader, int(config.GetInt("IMPORT_BATCH_SIZE", 500)))
+		for _, rowErr := range summary.Errors {
+			fmt.Fprintf(os.Stderr, "record %d (%s): %v\n", rowErr.Row, rowErr.Symbol, rowErr.Err)
+		}
+		if err != nil {
+			log.Fatalf("Could not import: %s", err.Error())
+		}
+		fmt.Fprintf(os.Stderr, "Restored %d crypto(s), %d failed\n", summary.Restored, summary.Failed)
+	default:
+		log.Fatal(catalogUsage)
+	}
+}
@@ -2,15 +2,33 @@ package main
 
 import (
 	"api/app/pb"
+	"api/auth"
+	"api/cache"
+	"api/challenge"
+	"api/changefeed"
 	"api/config"
 	"api/controllers"
 	"api/db"
+	"api/events"
+	"api/fraud"
+	"api/idempotency"
+	"api/jobs"
+	"api/migrations"
+	"api/outbox"
+	"api/suggest"
+	"api/trending"
+	"api/validator"
+	"api/votebuffer"
+	"api/webhooks"
 	"context"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"strings"
+	"time"
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/grpc"
@@ -38,20 +56,217 @@ func init() {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrate(os.Args[2:])
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "catalog" {
+		runCatalog(os.Args[2:])
+		return
+	}
+
+	if os.Getenv("MIGRATE_ON_START") == "true" {
+		runner, err := migrations.NewRunner(cryptoDb.Database())
+		if err != nil {
+			log.Fatalf("Invalid migrations: %s", err.Error())
+		}
+
+		if _, err := runner.Up(mongoCtx); err != nil {
+			log.Fatalf("Could not apply migrations: %s", err.Error())
+		}
+	}
+
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%s", apiPort))
 	if err != nil {
 		log.Fatalf("Could not connect on port :%s: %s", apiPort, err.Error())
 	}
 
-	grpcServer := grpc.NewServer()
+	var idempotencyKeys *idempotency.Store
+	if window := config.GetDuration("IDEMPOTENCY_WINDOW", 24*time.Hour); window > 0 {
+		idempotencyKeys = idempotency.NewStore(cryptoDb.Database(), window)
+	}
+
+	var challenges *challenge.Issuer
+	if secret := os.Getenv("VOTE_CHALLENGE_SECRET"); secret != "" {
+		minDifficulty := int(config.GetInt("VOTE_CHALLENGE_DIFFICULTY", 18))
+		maxDifficulty := int(config.GetInt("VOTE_CHALLENGE_MAX_DIFFICULTY", 26))
+		if minDifficulty < 0 || maxDifficulty < minDifficulty || maxDifficulty > 255 {
+			log.Fatalf("Invalid configuration: vote challenge difficulties must satisfy 0 <= VOTE_CHALLENGE_DIFFICULTY <= VOTE_CHALLENGE_MAX_DIFFICULTY <= 255")
+		}
+		challenges = challenge.NewIssuer([]byte(secret), config.GetDuration("VOTE_CHALLENGE_TTL", 2*time.Minute), minDifficulty, maxDifficulty,
+			config.GetFloat("VOTE_CHALLENGE_TARGET_RATE", 10), cryptoDb.Database())
+	}
+
+	adminToken := os.Getenv("ADMIN_TOKEN")
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			auth.UnaryServerInterceptor(adminToken),
+			validator.UnaryServerInterceptor(),
+			// a retry replayed from its idempotency key needs no new challenge
+			idempotency.UnaryServerInterceptor(idempotencyKeys),
+			challenge.UnaryServerInterceptor(challenges, adminToken),
+		),
+		grpc.ChainStreamInterceptor(
+			auth.StreamServerInterceptor(adminToken),
+			validator.StreamServerInterceptor(),
+			idempotency.StreamServerInterceptor(),
+		),
+	)
 	reflection.Register(grpcServer)
 
+	bus := events.NewBus()
+	// changes carries the writes of every instance when the change feed runs
+	changes := bus
+	var feed *changefeed.Feed
+	if os.Getenv("CHANGE_STREAM") == "true" {
+		name, _ := os.Hostname()
+		changes = events.NewBus()
+		feed = changefeed.NewFeed(cryptoDb, config.GetString("CHANGE_STREAM_NAME", name), changes)
+	}
+	suggestions := suggest.NewIndex()
+	if err := suggestions.Load(mongoCtx, cryptoDb); err != nil {
+		log.Fatalf("Could not load the suggestion index: %s", err.Error())
+	}
+
+	snapshotBucket, err := jobs.ParseBucket(config.GetString("VOTE_SNAPSHOT_BUCKET", "hour"))
+	if err != nil {
+		log.Fatalf("Invalid configuration: %s", err.Error())
+	}
+	snapshots := cryptoDb.Database().Collection("vote_snapshots")
+	voteEvents := cryptoDb.Database().Collection("vote_events")
+
+	ranker := trending.NewRanker()
+	if err := ranker.Load(mongoCtx, cryptoDb, voteEvents); err != nil {
+		log.Fatalf("Could not load trending cryptos: %s", err.Error())
+	}
+
 	cryptoService := controllers.CryptoServiceServer{
-		Db:  cryptoDb,
-		Ctx: mongoCtx,
+		Db:               cryptoDb,
+		StreamTimeout:    config.GetDuration("STREAM_MAX_DURATION", 30*time.Second),
+		StreamMaxResults: config.GetInt("STREAM_MAX_RESULTS", 1000),
+		ImportBatchSize:  int(config.GetInt("IMPORT_BATCH_SIZE", 500)),
+		Events:           bus,
+		Changes:          changes,
+		Suggestions:      suggestions,
+		Snapshots:        snapshots,
+		SnapshotBucket:   snapshotBucket,
+		VoteEvents:       voteEvents,
+		Trending:         ranker,
+		WalletVotes:      cryptoDb.Database().Collection("wallet_votes"),
+		VoteNonces:       cryptoDb.Database().Collection("vote_nonces"),
+		SignedVoteMaxAge: config.GetDuration("SIGNED_VOTE_MAX_AGE", 5*time.Minute),
+		Tallies:          cryptoDb.Database().Collection("vote_tallies"),
+		TallyLeaves:      cryptoDb.Database().Collection("tally_leaves"),
+		TallyNodes:       cryptoDb.Database().Collection("tally_nodes"),
+		Challenges:       challenges,
+	}
+
+	cryptoService.Transactions, err = db.SupportsTransactions(mongoCtx, cryptoDb.Database())
+	if err != nil {
+		log.Fatalf("Could not check the MongoDB deployment: %s", err.Error())
+	}
+
+	publisher, err := newOutboxPublisher()
+	if err != nil {
+		log.Fatalf("Invalid configuration: %s", err.Error())
+	}
+	// webhooks are queued from the outbox whenever transactions allow it
+	if publisher != nil || cryptoService.Transactions {
+		cryptoService.Outbox = outbox.NewStore(cryptoDb.Database())
+	}
+	var redis *cache.Redis
+	switch store := config.GetString("CACHE_STORE", ""); store {
+	case "":
+	case "memory":
+		cryptoService.Cache = cache.New(cache.NewLRU(int(config.GetInt("CACHE_SIZE", 10000))), config.GetDuration("CACHE_TTL", 30*time.Second))
+	case "redis":
+		redis = cache.NewRedis(
+			config.GetString("REDIS_ADDR", "localhost:6379"),
+			os.Getenv("REDIS_PASSWORD"),
+			config.GetString("CACHE_REDIS_PREFIX", "cryptos:"),
+			config.GetDuration("REDIS_TIMEOUT", time.Second),
+			int(config.GetInt("REDIS_POOL_SIZE", 16)),
+		)
+		cryptoService.Cache = cache.New(redis, config.GetDuration("CACHE_TTL", 30*time.Second))
+	default:
+		log.Fatalf("Invalid configuration: unknown CACHE_STORE %q", store)
+	}
+	if cryptoService.Cache != nil {
+		cryptoService.Cache.ListDelay = config.GetDuration("CACHE_LIST_VOTE_DELAY", time.Second)
+	}
+
+	var votes *votebuffer.Buffer
+	if dir := os.Getenv("VOTE_BUFFER_DIR"); dir != "" {
+		name, _ := os.Hostname()
+		// the node name is part of a field path
+		cryptoService.VoteNode = strings.NewReplacer(".", "_", "$", "_").Replace(config.GetString("VOTE_BUFFER_NODE", name))
+		votes, err = votebuffer.Open(dir, cryptoService.FlushVotes, int(config.GetInt("VOTE_BUFFER_MAX_PENDING", 10000)), config.GetDuration("VOTE_BUFFER_INTERVAL", time.Second))
+		if err != nil {
+			log.Fatalf("Could not open the vote buffer: %s", err.Error())
+		}
+		cryptoService.Votes = votes
+	}
+	cryptoService.FraudCases = fraud.NewStore(cryptoDb.Database())
+	if os.Getenv("FRAUD_DETECTION") == "true" {
+		cryptoService.Fraud, err = newFraudDetector()
+		if err != nil {
+			log.Fatalf("Invalid configuration: %s", err.Error())
+		}
+		cryptoService.TrustedProxies = int(config.GetInt("FRAUD_TRUSTED_PROXIES", 0))
 	}
 	pb.RegisterCryptoServiceServer(grpcServer, &cryptoService)
 
+	hooks := cryptoDb.Database().Collection("webhooks")
+	deliveries := cryptoDb.Database().Collection("webhook_deliveries")
+	pb.RegisterWebhookServiceServer(grpcServer, &controllers.WebhookServiceServer{
+		Hooks:      hooks,
+		Deliveries: deliveries,
+	})
+	webhookWorker := webhooks.Worker{
+		Hooks:        hooks,
+		Deliveries:   deliveries,
+		Client:       &http.Client{Timeout: config.GetDuration("WEBHOOK_TIMEOUT", 10*time.Second)},
+		MaxAttempts:  config.GetInt("WEBHOOK_MAX_ATTEMPTS", 8),
+		PollInterval: config.GetDuration("WEBHOOK_POLL_INTERVAL", time.Second),
+	}
+
+	jobsCtx, stopJobs := context.WithCancel(mongoCtx)
+	go suggestions.Watch(jobsCtx, cryptoDb, changes.Subscribe(256), config.GetDuration("SUGGEST_REFRESH_INTERVAL", 5*time.Minute))
+	go ranker.Watch(jobsCtx, cryptoDb, voteEvents, changes.Subscribe(256), config.GetDuration("TRENDING_REFRESH_INTERVAL", 5*time.Minute))
+	for i := int64(0); i < config.GetInt("WEBHOOK_WORKERS", 2); i++ {
+		go webhookWorker.Run(jobsCtx)
+	}
+	if cryptoService.Cache != nil {
+		go cryptoService.Cache.Watch(jobsCtx, changes.Subscribe(1024))
+	}
+	if votes != nil {
+		go votes.Run(jobsCtx)
+	}
+	if feed != nil {
+		go feed.Run(jobsCtx)
+	}
+	if cryptoService.Outbox != nil {
+		publishers := outbox.Publishers{&webhooks.Publisher{Hooks: hooks, Deliveries: deliveries}}
+		if publisher != nil {
+			publishers = append(publishers, publisher)
+		}
+		relay := outbox.NewRelay(cryptoDb.Database(), publishers, config.GetInt("OUTBOX_BATCH_SIZE", 100), config.GetDuration("OUTBOX_POLL_INTERVAL", time.Second))
+		go relay.Run(jobsCtx)
+	} else {
+		go webhooks.Dispatch(jobsCtx, hooks, deliveries, bus.Subscribe(1024))
+	}
+	go jobs.StartSnapshots(jobsCtx, cryptoDb, snapshots, snapshotBucket, bus.Subscribe(256))
+	if retention := config.GetDuration("TRASH_RETENTION", 30*24*time.Hour); retention > 0 {
+		go jobs.StartRetention(jobsCtx, cryptoService.PurgeExpired, retention, config.GetDuration("TRASH_PURGE_INTERVAL", time.Hour))
+	}
+	if cryptoService.Fraud != nil {
+		go fraud.Run(jobsCtx, cryptoService.FraudCases, cryptoService.Fraud, time.Minute)
+	}
+	if interval := config.GetDuration("TALLY_INTERVAL", 10*time.Minute); interval > 0 {
+		go jobs.StartTallies(jobsCtx, cryptoService.WalletVotes, cryptoService.Tallies, cryptoService.TallyLeaves, cryptoService.TallyNodes, interval)
+	}
+
 	go func() {
 		if err := grpcServer.Serve(listener); err != nil {
 			log.Fatalf("Failed to serve: %v", err)
@@ -65,9 +280,86 @@ func main() {
 	<-c
 
 	fmt.Println("\nStopping the server...")
+	stopJobs()
 	grpcServer.Stop()
 	listener.Close()
+	if votes != nil {
+		flushCtx, cancel := context.WithTimeout(mongoCtx, 30*time.Second)
+		if err := votes.Close(flushCtx); err != nil {
+			fmt.Printf("Buffered votes were left in the log: %s\n", err.Error())
+		}
+		cancel()
+	}
+	if publisher != nil {
+		publisher.Close()
+	}
+	if redis != nil {
+		redis.Close()
+	}
 	fmt.Println("Closing MongoDB connection")
 	cryptoDb.Database().Client().Disconnect(mongoCtx)
 	fmt.Println("Done.")
 }
+
+// newOutboxPublisher builds the publisher named by OUTBOX_PUBLISHER, or
+// returns nil when the outbox is disabled.
+func newOutboxPublisher() (outbox.Publisher, error) {
+	switch kind := config.GetString("OUTBOX_PUBLISHER", ""); kind {
+	case "":
+		return nil, nil
+	case "log":
+		return outbox.NewLogPublisher(config.GetString("OUTBOX_LOG_FILE", "outbox.log"))
+	case "nats":
+		return &outbox.NATSPublisher{
+			Addr:    config.GetString("NATS_ADDR", "localhost:4222"),
+			Subject: config.GetString("OUTBOX_NATS_SUBJECT", "cryptos"),
+			Timeout: config.GetDuration("OUTBOX_PUBLISH_TIMEOUT", 5*time.Second),
+		}, nil
+	case "kafka-rest":
+		return &outbox.KafkaRESTPublisher{
+			Url:    config.GetString("KAFKA_REST_URL", "http://localhost:8082"),
+			Topic:  config.GetString("OUTBOX_KAFKA_TOPIC", "cryptos"),
+			Client: &http.Client{Timeout: config.GetDuration("OUTBOX_PUBLISH_TIMEOUT", 5*time.Second)},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown OUTBOX_PUBLISHER %q", kind)
+	}
+}
+
+func newFraudDetector() (*fraud.Detector, error) {
+	defaults := fraud.DefaultConfig()
+	settings := fraud.Config{
+		BurstWindow:     config.GetDuration("FRAUD_BURST_WINDOW", defaults.BurstWindow),
+		BurstBaseline:   config.GetDuration("FRAUD_BURST_BASELINE", defaults.BurstBaseline),
+		BurstMin:        int(config.GetInt("FRAUD_BURST_MIN", int64(defaults.BurstMin))),
+		BurstFactor:     config.GetFloat("FRAUD_BURST_FACTOR", defaults.BurstFactor),
+		NetworkWindow:   config.GetDuration("FRAUD_NETWORK_WINDOW", defaults.NetworkWindow),
+		NetworkMax:      int(config.GetInt("FRAUD_NETWORK_MAX", int64(defaults.NetworkMax))),
+		AsnMax:          int(config.GetInt("FRAUD_ASN_MAX", int64(defaults.AsnMax))),
+		NewAccountAge:   config.GetDuration("FRAUD_NEW_ACCOUNT_AGE", defaults.NewAccountAge),
+		LockstepWindow:  config.GetDuration("FRAUD_LOCKSTEP_WINDOW", defaults.LockstepWindow),
+		LockstepMax:     int(config.GetInt("FRAUD_LOCKSTEP_MAX", int64(defaults.LockstepMax))),
+		FlagScore:       config.GetFloat("FRAUD_FLAG_SCORE", defaults.FlagScore),
+		DiscountScore:   config.GetFloat("FRAUD_DISCOUNT_SCORE", defaults.DiscountScore),
+		QuarantineScore: config.GetFloat("FRAUD_QUARANTINE_SCORE", defaults.QuarantineScore),
+		QuarantineFor:   config.GetDuration("FRAUD_QUARANTINE_FOR", defaults.QuarantineFor),
+	}
+	if settings.BurstWindow <= 0 {
+		return nil, fmt.Errorf("FRAUD_BURST_WINDOW must be positive")
+	}
+
+	var networks *fraud.Networks
+	if path := os.Getenv("FRAUD_ASN_FILE"); path != "" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		if networks, err = fraud.LoadNetworks(file); err != nil {
+			return nil, fmt.Errorf("FRAUD_ASN_FILE: %w", err)
+		}
+	}
+
+	return fraud.NewDetector(settings, networks), nil
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"api/migrations"
+	"fmt"
+	"log"
+	"strconv"
+)
+
+const migrateUsage = "usage: migrate up | down [steps] | status"
+
+func runMigrate(args []string) {
+	defer cryptoDb.Database().Client().Disconnect(mongoCtx)
+
+	if len(args) == 0 {
+		log.Fatal(migrateUsage)
+	}
+
+	runner, err := migrations.NewRunner(cryptoDb.Database())
+	if err != nil {
+		log.Fatalf("Invalid migrations: %s", err.Error())
+	}
+
+	switch args[0] {
+	case "up":
+		count, err := runner.Up(mongoCtx)
+		if err != nil {
+			log.Fatalf("Could not apply migrations: %s", err.Error())
+		}
+		fmt.Printf("Applied %d migration(s)\n", count)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				log.Fatalf("Invalid number of steps: %s", args[1])
+			}
+		}
+
+		count, err := runner.Down(mongoCtx, steps)
+		if err != nil {
+			log.Fatalf("Could not revert migrations: %s", err.Error())
+		}
+		fmt.Printf("Reverted %d migration(s)\n", count)
+	case "status":
+		status, err := runner.Status(mongoCtx)
+		if err != nil {
+			log.Fatalf("Could not read migration status: %s", err.Error())
+		}
+
+		for _, s := range status {
+			state := "pending"
+			if s.Applied {
+				state = "applied " + s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%4d  %-28s  %s\n", s.Version, state, s.Description)
+		}
+	default:
+		log.Fatal(migrateUsage)
+	}
+}
@@ -0,0 +1,311 @@
+package suggest
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+type Entry struct {
+	Id       string
+	Name     string
+	Symbol   string
+	Slug     string
+	VoteRate int64
+}
+
+// MaxLimit is the most suggestions a single lookup can return.
+const MaxLimit = 20
+
+type node struct {
+	children map[rune]*node
+	ids      map[string]struct{}
+	// top caches the MaxLimit best ids of the subtree until a change below
+	// marks the node dirty, so lookups do not walk the whole subtree.
+	top   []string
+	dirty bool
+}
+
+func newNode() *node {
+	return &node{children: map[rune]*node{}, dirty: true}
+}
+
+// Index is an in-memory trie over the full name, each name word and the
+// symbol of every crypto, answering prefix lookups ranked by voteRate.
+type Index struct {
+	mu      sync.Mutex
+	root    *node
+	entries map[string]Entry
+}
+
+func NewIndex() *Index {
+	return &Index{root: newNode(), entries: map[string]Entry{}}
+}
+
+func normalize(text string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+func keys(e Entry) []string {
+	name := normalize(e.Name)
+	result := []string{name, normalize(e.Symbol)}
+	words := strings.Fields(name)
+	if len(words) > 1 {
+		result = append(result, words[1:]...)
+	}
+
+	return result
+}
+
+func sameKeys(a, b Entry) bool {
+	return normalize(a.Name) == normalize(b.Name) && normalize(a.Symbol) == normalize(b.Symbol)
+}
+
+func (i *Index) insert(key, id string) {
+	n := i.root
+	n.dirty = true
+	for _, r := range key {
+		child, ok := n.children[r]
+		if !ok {
+			child = newNode()
+			n.children[r] = child
+		}
+		n = child
+		n.dirty = true
+	}
+
+	if n.ids == nil {
+		n.ids = map[string]struct{}{}
+	}
+	n.ids[id] = struct{}{}
+}
+
+func (i *Index) remove(key, id string) {
+	path := []*node{i.root}
+	n := i.root
+	for _, r := range key {
+		child, ok := n.children[r]
+		if !ok {
+			return
+		}
+		n = child
+		path = append(path, n)
+	}
+	delete(n.ids, id)
+	for _, visited := range path {
+		visited.dirty = true
+	}
+
+	// prune the branch back up while it holds nothing
+	runes := []rune(key)
+	for depth := len(runes); depth > 0; depth-- {
+		current := path[depth]
+		if len(current.ids) > 0 || len(current.children) > 0 {
+			break
+		}
+		delete(path[depth-1].children, runes[depth-1])
+	}
+}
+
+// rerank updates the cached top lists along key after the voteRate of id
+// changed. Only a top entry dropping in rank can let an unseen id in, so that
+// is the one case where the node is recomputed on the next lookup.
+func (i *Index) rerank(key, id string, increased bool) {
+	n := i.root
+	for _, r := range key {
+		i.rerankNode(n, id, increased)
+		child, ok := n.children[r]
+		if !ok {
+			return
+		}
+		n = child
+	}
+	i.rerankNode(n, id, increased)
+}
+
+func (i *Index) rerankNode(n *node, id string, increased bool) {
+	if n.dirty {
+		return
+	}
+
+	at := -1
+	for j, existing := range n.top {
+		if existing == id {
+			at = j
+			break
+		}
+	}
+
+	switch {
+	case at < 0 && len(n.top) < MaxLimit:
+		n.dirty = true
+		return
+	case at < 0 && !i.better(id, n.top[len(n.top)-1]):
+		return
+	case at < 0:
+		n.top = n.top[:len(n.top)-1]
+	case !increased && len(n.top) == MaxLimit:
+		n.dirty = true
+		return
+	default:
+		n.top = append(n.top[:at], n.top[at+1:]...)
+	}
+
+	pos := sort.Search(len(n.top), func(j int) bool { return i.better(id, n.top[j]) })
+	n.top = append(n.top, "")
+	copy(n.top[pos+1:], n.top[pos:])
+	n.top[pos] = id
+}
+
+func (i *Index) better(a, b string) bool {
+	ea, eb := i.entries[a], i.entries[b]
+	if ea.VoteRate != eb.VoteRate {
+		return ea.VoteRate > eb.VoteRate
+	}
+	if ea.Name != eb.Name {
+		return ea.Name < eb.Name
+	}
+	return a < b
+}
+
+// best returns the cached top ids of n's subtree, recomputing dirty nodes
+// from their own ids and their children's top lists.
+func (i *Index) best(n *node) []string {
+	if !n.dirty {
+		return n.top
+	}
+
+	top := make([]string, 0, MaxLimit)
+	consider := func(id string) {
+		if len(top) == MaxLimit && !i.better(id, top[len(top)-1]) {
+			return
+		}
+		for _, existing := range top {
+			if existing == id {
+				return
+			}
+		}
+
+		at := sort.Search(len(top), func(j int) bool { return i.better(id, top[j]) })
+		if len(top) < MaxLimit {
+			top = append(top, "")
+		}
+		copy(top[at+1:], top[at:])
+		top[at] = id
+	}
+
+	for id := range n.ids {
+		consider(id)
+	}
+	for _, child := range n.children {
+		for _, id := range i.best(child) {
+			consider(id)
+		}
+	}
+
+	n.top, n.dirty = top, false
+	return n.top
+}
+
+func (i *Index) Replace(entries []Entry) {
+	root := newNode()
+	byId := make(map[string]Entry, len(entries))
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.root, i.entries = root, byId
+	for _, e := range entries {
+		i.entries[e.Id] = e
+		for _, key := range keys(e) {
+			i.insert(key, e.Id)
+		}
+	}
+	i.best(i.root)
+}
+
+func (i *Index) Upsert(e Entry) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	old, exists := i.entries[e.Id]
+	i.entries[e.Id] = e
+	if exists && sameKeys(old, e) {
+		// the name breaks voteRate ties, so a rename that keeps the keys
+		// may still move the entry
+		if old.VoteRate != e.VoteRate || old.Name != e.Name {
+			increased := e.VoteRate > old.VoteRate || e.VoteRate == old.VoteRate && e.Name < old.Name
+			for _, key := range keys(e) {
+				i.rerank(key, e.Id, increased)
+			}
+		}
+		return
+	}
+
+	if exists {
+		for _, key := range keys(old) {
+			i.remove(key, old.Id)
+		}
+	}
+	for _, key := range keys(e) {
+		i.insert(key, e.Id)
+	}
+}
+
+func (i *Index) Remove(id string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	old, exists := i.entries[id]
+	if !exists {
+		return
+	}
+
+	delete(i.entries, id)
+	for _, key := range keys(old) {
+		i.remove(key, id)
+	}
+}
+
+func (i *Index) Len() int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return len(i.entries)
+}
+
+// Suggest returns up to limit (at most MaxLimit) entries with a key starting
+// with prefix, most popular (highest voteRate) first.
+func (i *Index) Suggest(prefix string, limit int) []Entry {
+	prefix = normalize(prefix)
+	if prefix == "" || limit <= 0 {
+		return nil
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	n := i.root
+	for _, r := range prefix {
+		child, ok := n.children[r]
+		if !ok {
+			return nil
+		}
+		n = child
+	}
+
+	top := i.best(n)
+	if len(top) > limit {
+		top = top[:limit]
+	}
+
+	matches := make([]Entry, len(top))
+	for j, id := range top {
+		matches[j] = i.entries[id]
+	}
+
+	return matches
+}
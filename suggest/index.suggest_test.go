@@ -0,0 +1,143 @@
+package suggest
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var words = []string{"bit", "Bitcoin", "coin", "COIN", "doge", "dot", "ether", "eth-classic", "lite", "litecoin"}
+
+func randomEntry(random *rand.Rand, id string) Entry {
+	name := words[random.Intn(len(words))]
+	if random.Intn(2) == 0 {
+		name += " " + words[random.Intn(len(words))]
+	}
+	symbol := string(rune('a'+random.Intn(4))) + string(rune('a'+random.Intn(4)))
+
+	return Entry{Id: id, Name: name, Symbol: symbol, Slug: strings.ToLower(name), VoteRate: int64(random.Intn(7) - 3)}
+}
+
+// scan is the brute-force answer to Suggest over every entry.
+func scan(entries map[string]Entry, prefix string, limit int) []Entry {
+	prefix = normalize(prefix)
+	var matches []Entry
+	for _, e := range entries {
+		for _, key := range keys(e) {
+			if strings.HasPrefix(key, prefix) {
+				matches = append(matches, e)
+				break
+			}
+		}
+	}
+
+	sort.Slice(matches, func(a, b int) bool {
+		if matches[a].VoteRate != matches[b].VoteRate {
+			return matches[a].VoteRate > matches[b].VoteRate
+		}
+		if matches[a].Name != matches[b].Name {
+			return matches[a].Name < matches[b].Name
+		}
+		return matches[a].Id < matches[b].Id
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	return matches
+}
+
+func TestIndexMatchesScan(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	prefixes := []string{"b", "bi", "bitc", "c", "coin", "d", "do", "e", "eth c", "l", "lite", "a", "ab", "dd", "x"}
+
+	index := NewIndex()
+	entries := map[string]Entry{}
+	var initial []Entry
+	for n := 0; n < 40; n++ {
+		e := randomEntry(random, strconv.Itoa(n))
+		initial = append(initial, e)
+		entries[e.Id] = e
+	}
+	index.Replace(initial)
+
+	for step := 0; step < 3000; step++ {
+		id := strconv.Itoa(random.Intn(60))
+		old, exists := entries[id]
+		var op string
+		switch choice := random.Intn(10); {
+		case choice < 5 && exists:
+			op = "vote"
+			e := old
+			e.VoteRate += int64(random.Intn(5) - 2)
+			entries[id] = e
+			index.Upsert(e)
+		case choice < 7 && exists:
+			op = "rename"
+			e := randomEntry(random, id)
+			e.VoteRate = old.VoteRate
+			if random.Intn(3) == 0 {
+				// same keys, but the name still breaks ties
+				e = old
+				e.Name = strings.ToUpper(old.Name)
+				if e.Name == old.Name {
+					e.Name = strings.ToLower(old.Name)
+				}
+			}
+			entries[id] = e
+			index.Upsert(e)
+		case choice < 8:
+			op = "delete"
+			delete(entries, id)
+			index.Remove(id)
+		default:
+			op = "insert"
+			e := randomEntry(random, id)
+			entries[id] = e
+			index.Upsert(e)
+		}
+
+		if index.Len() != len(entries) {
+			t.Fatalf("step %d (%s): index holds %d entries, want %d", step, op, index.Len(), len(entries))
+		}
+		for _, prefix := range prefixes {
+			limit := 1 + random.Intn(MaxLimit)
+			got, want := index.Suggest(prefix, limit), scan(entries, prefix, limit)
+			if len(got) != len(want) {
+				t.Fatalf("step %d (%s): %q gave %d suggestions, want %d", step, op, prefix, len(got), len(want))
+			}
+			for j := range want {
+				if got[j] != want[j] {
+					t.Fatalf("step %d (%s): suggestion %d for %q is %+v, want %+v", step, op, j, prefix, got[j], want[j])
+				}
+			}
+		}
+	}
+}
+
+func TestSuggestRejectsEmptyLookups(t *testing.T) {
+	index := NewIndex()
+	index.Replace([]Entry{{Id: "1", Name: "Bitcoin", Symbol: "BTC"}})
+
+	if got := index.Suggest(" -", MaxLimit); got != nil {
+		t.Fatalf("a prefix without letters gave %v", got)
+	}
+	if got := index.Suggest("bit", 0); got != nil {
+		t.Fatalf("a zero limit gave %v", got)
+	}
+	if got := index.Suggest("BIT", MaxLimit); len(got) != 1 || got[0].Id != "1" {
+		t.Fatalf("an upper case prefix gave %v, want the crypto", got)
+	}
+}
+
+func TestRenameKeepingKeysReranks(t *testing.T) {
+	index := NewIndex()
+	index.Replace([]Entry{{Id: "1", Name: "coin", Symbol: "aa"}, {Id: "2", Name: "Coin", Symbol: "ab"}})
+
+	index.Upsert(Entry{Id: "1", Name: "COIN", Symbol: "aa"})
+	if got := index.Suggest("coin", MaxLimit); len(got) != 2 || got[0].Id != "1" {
+		t.Fatalf("after the rename the suggestions are %v, want 1 first", got)
+	}
+}
@@ -0,0 +1,80 @@
+package suggest
+
+import (
+	"api/events"
+	"api/models"
+	"context"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func entryOf(data models.CryptoItem) Entry {
+	return Entry{
+		Id:       data.Id.Hex(),
+		Name:     data.Name,
+		Symbol:   data.Symbol,
+		Slug:     data.Slug,
+		VoteRate: data.VoteRate,
+	}
+}
+
+// Load rebuilds the index from every crypto that is not in the trash.
+func (i *Index) Load(ctx context.Context, db *mongo.Collection) error {
+	projection := bson.M{"name": 1, "symbol": 1, "slug": 1, "voteRate": 1}
+	cursor, err := db.Find(ctx, bson.M{"deletedAt": nil}, options.Find().SetProjection(projection))
+	if err != nil {
+		return err
+	}
+
+	defer cursor.Close(ctx)
+
+	var entries []Entry
+	for cursor.Next(ctx) {
+		var data models.CryptoItem
+		if err := cursor.Decode(&data); err != nil {
+			return err
+		}
+		entries = append(entries, entryOf(data))
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+
+	i.Replace(entries)
+	return nil
+}
+
+// Watch keeps the index current from change events, and reloads it every
+// interval to pick up events that were dropped or happened elsewhere.
+func (i *Index) Watch(ctx context.Context, db *mongo.Collection, changes <-chan events.Event, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	reload := func() {
+		if err := i.Load(ctx, db); err != nil && ctx.Err() == nil {
+			log.Printf("Could not refresh the suggestion index: %v", err)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reload()
+		case event := <-changes:
+			switch event.Type {
+			case events.Created, events.Updated, events.Voted, events.Restored:
+				i.Upsert(entryOf(event.Crypto))
+			case events.Deleted, events.Purged:
+				i.Remove(event.CryptoId)
+			case events.Imported:
+				reload()
+			}
+		}
+	}
+}
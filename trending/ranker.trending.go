@@ -0,0 +1,231 @@
+package trending
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Window is a sliding period over which votes are summed. Votes are kept in
+// Slot-wide buckets, so the window slides one slot at a time.
+type Window struct {
+	Span time.Duration
+	Slot time.Duration
+}
+
+var (
+	Hour = Window{Span: time.Hour, Slot: time.Minute}
+	Day  = Window{Span: 24 * time.Hour, Slot: 15 * time.Minute}
+	Week = Window{Span: 7 * 24 * time.Hour, Slot: time.Hour}
+)
+
+var Windows = []Window{Hour, Day, Week}
+
+type Votes struct {
+	Likes    int64
+	Dislikes int64
+}
+
+func (v Votes) Net() int64 {
+	return v.Likes - v.Dislikes
+}
+
+func (v Votes) add(other Votes, sign int64) Votes {
+	return Votes{Likes: v.Likes + sign*other.Likes, Dislikes: v.Dislikes + sign*other.Dislikes}
+}
+
+type Entry struct {
+	Id string
+	Votes
+}
+
+type slot struct {
+	start time.Time
+	votes map[string]Votes
+}
+
+// window keeps running totals that are adjusted as votes arrive and slots
+// expire, along with the entries ranked by those totals: each change moves
+// one entry to its new rank, so reading the ranking never sorts.
+type window struct {
+	Window
+	slots  []slot
+	totals map[string]Votes
+	ranked []Entry
+}
+
+func newWindow(w Window) *window {
+	return &window{
+		Window: w,
+		slots:  make([]slot, int(w.Span/w.Slot)+1),
+		totals: map[string]Votes{},
+	}
+}
+
+// ranksBefore orders entries by net votes, then likes, then id.
+func ranksBefore(a, b Entry) bool {
+	if a.Net() != b.Net() {
+		return a.Net() > b.Net()
+	}
+	if a.Likes != b.Likes {
+		return a.Likes > b.Likes
+	}
+	return a.Id < b.Id
+}
+
+// rank returns where entry is, or belongs, among ranked.
+func rank(ranked []Entry, entry Entry) int {
+	return sort.Search(len(ranked), func(i int) bool { return !ranksBefore(ranked[i], entry) })
+}
+
+// set changes the total of id and moves its entry to its new rank, shifting
+// only the entries in between; votes rarely move an entry far.
+func (w *window) set(id string, total Votes) {
+	old, ok := w.totals[id]
+	if total == (Votes{}) {
+		if ok {
+			i := rank(w.ranked, Entry{Id: id, Votes: old})
+			w.ranked = append(w.ranked[:i], w.ranked[i+1:]...)
+			delete(w.totals, id)
+		}
+		return
+	}
+
+	w.totals[id] = total
+	entry := Entry{Id: id, Votes: total}
+	if !ok {
+		i := rank(w.ranked, entry)
+		w.ranked = append(w.ranked, Entry{})
+		copy(w.ranked[i+1:], w.ranked[i:])
+		w.ranked[i] = entry
+		return
+	}
+
+	i := rank(w.ranked, Entry{Id: id, Votes: old})
+	if j := rank(w.ranked[:i], entry); j < i {
+		copy(w.ranked[j+1:i+1], w.ranked[j:i])
+		w.ranked[j] = entry
+		return
+	}
+	j := i + rank(w.ranked[i+1:], entry)
+	copy(w.ranked[i:j], w.ranked[i+1:j+1])
+	w.ranked[j] = entry
+}
+
+func (w *window) expired(start, now time.Time) bool {
+	return !start.Add(w.Slot).After(now.Add(-w.Span))
+}
+
+func (w *window) clear(s *slot) {
+	for id, votes := range s.votes {
+		w.set(id, w.totals[id].add(votes, -1))
+	}
+	s.votes = nil
+}
+
+func (w *window) add(id string, votes Votes, at, now time.Time) {
+	start := at.Truncate(w.Slot)
+	if w.expired(start, now) || start.After(now) {
+		return
+	}
+
+	s := &w.slots[int(start.UnixNano()/int64(w.Slot))%len(w.slots)]
+	if !s.start.Equal(start) {
+		w.clear(s)
+		s.start = start
+	}
+	if s.votes == nil {
+		s.votes = map[string]Votes{}
+	}
+
+	s.votes[id] = s.votes[id].add(votes, 1)
+	w.set(id, w.totals[id].add(votes, 1))
+}
+
+func (w *window) advance(now time.Time) {
+	for i := range w.slots {
+		if w.slots[i].votes != nil && w.expired(w.slots[i].start, now) {
+			w.clear(&w.slots[i])
+		}
+	}
+}
+
+func (w *window) remove(id string) {
+	for i := range w.slots {
+		delete(w.slots[i].votes, id)
+	}
+	w.set(id, Votes{})
+}
+
+// Ranker ranks cryptos by their net votes within each of the Windows.
+type Ranker struct {
+	mu      sync.Mutex
+	windows map[Window]*window
+}
+
+func newWindows() map[Window]*window {
+	windows := make(map[Window]*window, len(Windows))
+	for _, w := range Windows {
+		windows[w] = newWindow(w)
+	}
+
+	return windows
+}
+
+func NewRanker() *Ranker {
+	return &Ranker{windows: newWindows()}
+}
+
+// Add counts a vote change made at the given time in every window still
+// covering it.
+func (r *Ranker) Add(id string, votes Votes, at time.Time) {
+	now := time.Now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, w := range r.windows {
+		w.add(id, votes, at, now)
+	}
+}
+
+// Advance drops the slots that slid out of their window.
+func (r *Ranker) Advance(now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, w := range r.windows {
+		w.advance(now)
+	}
+}
+
+func (r *Ranker) Remove(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, w := range r.windows {
+		w.remove(id)
+	}
+}
+
+// Top returns up to limit cryptos with votes in the window, highest net
+// votes first. A limit of zero returns all of them.
+func (r *Ranker) Top(window Window, limit int) []Entry {
+	now := time.Now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	w, ok := r.windows[window]
+	if !ok {
+		return nil
+	}
+
+	w.advance(now)
+	ranked := w.ranked
+	if limit > 0 && len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+
+	return append([]Entry(nil), ranked...)
+}
@@ -0,0 +1,44 @@
+package trending
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestRankingFollowsTotals(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	w := newWindow(Hour)
+	now := time.Now()
+
+	for step := 0; step < 5000; step++ {
+		id := strconv.Itoa(random.Intn(50))
+		switch random.Intn(10) {
+		case 0:
+			w.remove(id)
+		case 1:
+			now = now.Add(time.Duration(random.Intn(10)) * time.Minute)
+			w.advance(now)
+		default:
+			votes := Votes{Likes: int64(random.Intn(5) - 2), Dislikes: int64(random.Intn(5) - 2)}
+			w.add(id, votes, now.Add(-time.Duration(random.Intn(70))*time.Minute), now)
+		}
+
+		want := make([]Entry, 0, len(w.totals))
+		for id, votes := range w.totals {
+			want = append(want, Entry{Id: id, Votes: votes})
+		}
+		sort.Slice(want, func(i, j int) bool { return ranksBefore(want[i], want[j]) })
+
+		if len(w.ranked) != len(want) {
+			t.Fatalf("step %d: ranked %d entries, want %d", step, len(w.ranked), len(want))
+		}
+		for i := range want {
+			if w.ranked[i] != want[i] {
+				t.Fatalf("step %d: rank %d is %+v, want %+v", step, i, w.ranked[i], want[i])
+			}
+		}
+	}
+}
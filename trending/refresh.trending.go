@@ -0,0 +1,126 @@
+package trending
+
+import (
+	"api/events"
+	"context"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type minuteVotes struct {
+	Key struct {
+		CryptoId primitive.ObjectID `bson:"cryptoId"`
+		Minute   time.Time          `bson:"minute"`
+	} `bson:"_id"`
+	Likes    int64 `bson:"likes"`
+	Dislikes int64 `bson:"dislikes"`
+}
+
+// trashed returns the ids of the cryptos currently in the trash.
+func trashed(ctx context.Context, db *mongo.Collection) (map[string]bool, error) {
+	cursor, err := db.Find(ctx, bson.M{"deletedAt": bson.M{"$ne": nil}}, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+
+	defer cursor.Close(ctx)
+
+	ids := map[string]bool{}
+	for cursor.Next(ctx) {
+		var doc struct {
+			Id primitive.ObjectID `bson:"_id"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		ids[doc.Id.Hex()] = true
+	}
+
+	return ids, cursor.Err()
+}
+
+// Load rebuilds every window from the vote events recorded over the longest
+// window, summed per crypto and minute.
+func (r *Ranker) Load(ctx context.Context, db, votes *mongo.Collection) error {
+	now := time.Now()
+	since := now.Add(-Week.Span - Week.Slot)
+
+	skip, err := trashed(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	minute := bson.M{"$subtract": bson.A{"$at", bson.M{"$mod": bson.A{bson.M{"$toLong": "$at"}, time.Minute.Milliseconds()}}}}
+	cursor, err := votes.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"at": bson.M{"$gte": since}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":      bson.M{"cryptoId": "$cryptoId", "minute": minute},
+			"likes":    bson.M{"$sum": "$likes"},
+			"dislikes": bson.M{"$sum": "$dislikes"},
+		}}},
+	})
+	if err != nil {
+		return err
+	}
+
+	defer cursor.Close(ctx)
+
+	windows := newWindows()
+	for cursor.Next(ctx) {
+		var row minuteVotes
+		if err := cursor.Decode(&row); err != nil {
+			return err
+		}
+
+		id := row.Key.CryptoId.Hex()
+		if skip[id] {
+			continue
+		}
+		for _, w := range windows {
+			w.add(id, Votes{Likes: row.Likes, Dislikes: row.Dislikes}, row.Key.Minute, now)
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.windows = windows
+	r.mu.Unlock()
+
+	return nil
+}
+
+// Watch counts votes as they are published, slides the windows every minute
+// and reloads them every interval to include votes cast on other instances.
+func (r *Ranker) Watch(ctx context.Context, db, votes *mongo.Collection, changes <-chan events.Event, interval time.Duration) {
+	slide := time.NewTicker(time.Minute)
+	defer slide.Stop()
+	reload := time.NewTicker(interval)
+	defer reload.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-slide.C:
+			r.Advance(now)
+		case <-reload.C:
+			if err := r.Load(ctx, db, votes); err != nil && ctx.Err() == nil {
+				log.Printf("Could not refresh trending cryptos: %v", err)
+			}
+		case event := <-changes:
+			switch event.Type {
+			case events.Voted:
+				r.Add(event.CryptoId, Votes{Likes: event.LikesDelta, Dislikes: event.DislikesDelta}, event.OccurredAt)
+			case events.Deleted, events.Purged:
+				r.Remove(event.CryptoId)
+			}
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Slugify turns free text into a lowercase, URL-safe identifier,
+// e.g. "Bitcoin Cash (BCH)" becomes "bitcoin-cash-bch".
+func Slugify(text string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range norm.NFKD.String(text) {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			b.WriteRune(unicode.ToLower(r))
+			dash = false
+		case unicode.Is(unicode.Mn, r):
+			// drop accents left over from decomposition
+		case b.Len() > 0 && !dash:
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "-")
+}
+
+func NormalizeSymbol(symbol string) string {
+	return strings.ToUpper(strings.TrimSpace(symbol))
+}
@@ -0,0 +1,123 @@
+package validator
+
+import (
+	"api/app/pb"
+	"fmt"
+	"regexp"
+	"sync"
+	"unicode/utf8"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var patterns sync.Map
+
+func compile(pattern string) *regexp.Regexp {
+	if re, ok := patterns.Load(pattern); ok {
+		return re.(*regexp.Regexp)
+	}
+
+	re := regexp.MustCompile(pattern)
+	patterns.Store(pattern, re)
+	return re
+}
+
+// Validate checks msg against the (rules) options declared in the proto
+// files and returns one violation per failing field, nested messages included.
+func Validate(msg proto.Message) []*errdetails.BadRequest_FieldViolation {
+	return validateMessage(msg.ProtoReflect(), "")
+}
+
+func validateMessage(m protoreflect.Message, prefix string) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := prefix + string(fd.Name())
+		rules, _ := proto.GetExtension(fd.Options(), pb.E_Rules).(*pb.FieldRules)
+
+		switch {
+		case fd.IsList():
+			list := m.Get(fd).List()
+			if rules.GetRequired() && list.Len() == 0 {
+				violations = append(violations, violation(path, "is required"))
+			}
+			if rules.GetMaxItems() > 0 && uint32(list.Len()) > rules.GetMaxItems() {
+				violations = append(violations, violation(path, fmt.Sprintf("must have at most %d items", rules.GetMaxItems())))
+				continue
+			}
+			for j := 0; j < list.Len(); j++ {
+				elementPath := fmt.Sprintf("%s[%d]", path, j)
+				violations = append(violations, validateValue(fd, rules, list.Get(j), elementPath)...)
+			}
+		case fd.IsMap():
+			continue
+		default:
+			if fd.Kind() == protoreflect.MessageKind && !m.Has(fd) {
+				if rules.GetRequired() {
+					violations = append(violations, violation(path, "is required"))
+				}
+				continue
+			}
+			violations = append(violations, validateValue(fd, rules, m.Get(fd), path)...)
+		}
+	}
+
+	return violations
+}
+
+func validateValue(fd protoreflect.FieldDescriptor, rules *pb.FieldRules, value protoreflect.Value, path string) []*errdetails.BadRequest_FieldViolation {
+	switch fd.Kind() {
+	case protoreflect.MessageKind:
+		return validateMessage(value.Message(), path+".")
+	case protoreflect.EnumKind:
+		if rules.GetRequired() && value.Enum() == 0 {
+			return []*errdetails.BadRequest_FieldViolation{violation(path, "is required")}
+		}
+	case protoreflect.StringKind:
+		if rules == nil {
+			return nil
+		}
+		if v := validateString(rules, value.String(), path); v != nil {
+			return []*errdetails.BadRequest_FieldViolation{v}
+		}
+	}
+
+	return nil
+}
+
+func validateString(rules *pb.FieldRules, value string, path string) *errdetails.BadRequest_FieldViolation {
+	if value == "" {
+		if rules.GetRequired() || rules.GetObjectId() {
+			return violation(path, "is required")
+		}
+		return nil
+	}
+
+	length := uint32(utf8.RuneCountInString(value))
+	switch {
+	case !utf8.ValidString(value):
+		return violation(path, "must be valid UTF-8")
+	case rules.GetObjectId() && !primitive.IsValidObjectID(value):
+		return violation(path, "must be a 24 character hex ObjectId")
+	case rules.GetMinLen() > 0 && length < rules.GetMinLen():
+		return violation(path, fmt.Sprintf("must be at least %d characters", rules.GetMinLen()))
+	case rules.GetMaxLen() > 0 && length > rules.GetMaxLen():
+		return violation(path, fmt.Sprintf("must be at most %d characters", rules.GetMaxLen()))
+	case rules.GetPattern() != "" && !compile(rules.GetPattern()).MatchString(value):
+		return violation(path, fmt.Sprintf("must match %s", rules.GetPattern()))
+	}
+
+	return nil
+}
+
+func violation(field, description string) *errdetails.BadRequest_FieldViolation {
+	return &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: fmt.Sprintf("%s %s", field, description),
+	}
+}
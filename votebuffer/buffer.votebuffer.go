@@ -0,0 +1,267 @@
+package votebuffer
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var ErrClosed = errors.New("the vote buffer is closed")
+
+type Delta struct {
+	Likes    int64
+	Dislikes int64
+}
+
+func (d Delta) add(other Delta) Delta {
+	return Delta{Likes: d.Likes + other.Likes, Dislikes: d.Dislikes + other.Dislikes}
+}
+
+// FlushFunc applies the deltas of one segment. It may be called again with
+// the same seq after a failure or a crash, and must not apply them twice.
+type FlushFunc func(ctx context.Context, seq int64, deltas map[primitive.ObjectID]Delta) error
+
+// Buffer sums votes per crypto in memory and flushes them in bulk every
+// Interval, or sooner once MaxPending votes are waiting. Each vote is in the
+// write-ahead log before Add returns, so votes not yet flushed are replayed
+// by the next Open after a crash.
+type Buffer struct {
+	Dir        string
+	Flush      FlushFunc
+	MaxPending int
+	Interval   time.Duration
+
+	mu sync.Mutex
+	// current receives new votes; rotated segments wait to be flushed
+	current *segment
+	rotated []*segment
+	lastSeq int64
+	closed  bool
+
+	flushMu sync.Mutex
+	kick    chan struct{}
+}
+
+// Open replays the segments left in dir and opens a new one. The replayed
+// votes are flushed by the first flush, before any new vote.
+func Open(dir string, flush FlushFunc, maxPending int, interval time.Duration) (*Buffer, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	rotated, err := readSegments(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Buffer{
+		Dir:        dir,
+		Flush:      flush,
+		MaxPending: maxPending,
+		Interval:   interval,
+		rotated:    rotated,
+		kick:       make(chan struct{}, 1),
+	}
+	if len(rotated) > 0 {
+		b.lastSeq = rotated[len(rotated)-1].Seq
+		log.Printf("Replaying %d vote log segments", len(rotated))
+	}
+
+	if b.current, err = createSegment(dir, b.nextSeq()); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// nextSeq derives sequence numbers from the clock so they keep growing
+// across restarts, even once every segment was flushed and removed.
+func (b *Buffer) nextSeq() int64 {
+	seq := time.Now().UnixNano()
+	if seq <= b.lastSeq {
+		seq = b.lastSeq + 1
+	}
+
+	b.lastSeq = seq
+	return seq
+}
+
+// Add records a vote on top of base, the stored counts of the crypto with
+// the segments up to applied flushed into them, and returns the delta
+// actually recorded: like unbuffered votes, counts never drop below zero.
+func (b *Buffer) Add(id primitive.ObjectID, base Delta, applied int64, delta Delta) (Delta, error) {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return Delta{}, ErrClosed
+	}
+
+	current := base.add(b.pendingLocked(id, applied))
+	if current.Likes+delta.Likes < 0 {
+		delta.Likes = -current.Likes
+	}
+	if current.Dislikes+delta.Dislikes < 0 {
+		delta.Dislikes = -current.Dislikes
+	}
+	if delta == (Delta{}) {
+		b.mu.Unlock()
+		return delta, nil
+	}
+
+	segment := b.current
+	offset, err := segment.append(id, delta)
+	if err != nil {
+		b.mu.Unlock()
+		return Delta{}, err
+	}
+	full := b.MaxPending > 0 && segment.records >= b.MaxPending
+	segment.adding.Add(1)
+	b.mu.Unlock()
+
+	defer segment.adding.Done()
+	if err := segment.sync(offset); err != nil {
+		return Delta{}, err
+	}
+
+	b.mu.Lock()
+	segment.Deltas[id] = segment.Deltas[id].add(delta)
+	b.mu.Unlock()
+
+	if full {
+		select {
+		case b.kick <- struct{}{}:
+		default:
+		}
+	}
+
+	return delta, nil
+}
+
+// Pending returns the votes on id missing from a copy of the crypto that
+// has the segments up to applied flushed into it. Comparing sequence numbers
+// rather than dropping flushed segments keeps reads exact while a flush is
+// under way, and with copies read before it.
+func (b *Buffer) Pending(id primitive.ObjectID, applied int64) Delta {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.pendingLocked(id, applied)
+}
+
+func (b *Buffer) pendingLocked(id primitive.ObjectID, applied int64) Delta {
+	pending := b.current.Deltas[id]
+	for _, segment := range b.rotated {
+		if segment.Seq > applied {
+			pending = pending.add(segment.Deltas[id])
+		}
+	}
+
+	return pending
+}
+
+// Run flushes right away, to apply replayed votes, then on every tick and
+// whenever the open segment fills up, until ctx is done.
+func (b *Buffer) Run(ctx context.Context) {
+	ticker := time.NewTicker(b.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := b.flush(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Could not flush buffered votes: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-b.kick:
+		}
+	}
+}
+
+// flush rotates the open segment and flushes every rotated one in order,
+// stopping at the first failure so later segments never overtake it.
+func (b *Buffer) flush(ctx context.Context) error {
+	b.flushMu.Lock()
+	defer b.flushMu.Unlock()
+
+	if err := b.rotate(); err != nil {
+		return err
+	}
+
+	for {
+		b.mu.Lock()
+		if len(b.rotated) == 0 {
+			b.mu.Unlock()
+			return nil
+		}
+		segment := b.rotated[0]
+		b.mu.Unlock()
+
+		segment.adding.Wait()
+		if len(segment.Deltas) > 0 {
+			if err := b.Flush(ctx, segment.Seq, segment.Deltas); err != nil {
+				return err
+			}
+		}
+		if err := os.Remove(segment.path); err != nil {
+			return err
+		}
+
+		b.mu.Lock()
+		b.rotated = b.rotated[1:]
+		b.mu.Unlock()
+	}
+}
+
+func (b *Buffer) rotate() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.current.records == 0 {
+		return nil
+	}
+
+	next, err := createSegment(b.Dir, b.nextSeq())
+	if err != nil {
+		return err
+	}
+
+	// votes still waiting for their fsync are covered by this one
+	previous := b.current
+	if err := previous.sync(atomic.LoadInt64(&previous.written)); err != nil {
+		next.file.Close()
+		os.Remove(next.path)
+		return err
+	}
+	previous.file.Close()
+
+	b.rotated = append(b.rotated, previous)
+	b.current = next
+	return nil
+}
+
+// Close stops accepting votes and flushes the buffered ones. Votes it
+// cannot flush stay in the log for the next Open.
+func (b *Buffer) Close(ctx context.Context) error {
+	b.mu.Lock()
+	b.closed = true
+	b.mu.Unlock()
+
+	err := b.flush(ctx)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.current.file.Close()
+	if b.current.records == 0 {
+		os.Remove(b.current.path)
+	}
+	return err
+}
@@ -0,0 +1,209 @@
+package votebuffer
+
+import (
+	"context"
+	"errors"
+	"os"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	cryptoA = primitive.ObjectID{1}
+	cryptoB = primitive.ObjectID{2}
+)
+
+// store applies flushed segments the way FlushVotes does: each crypto
+// remembers the last segment applied to it and skips older or equal ones.
+type store struct {
+	mu      sync.Mutex
+	counts  map[primitive.ObjectID]Delta
+	applied map[primitive.ObjectID]int64
+	flushes []int64
+	// fail makes the next flushes fail after applying the segment, like a
+	// write that succeeded but whose reply was lost
+	fail int
+}
+
+func newStore() *store {
+	return &store{counts: map[primitive.ObjectID]Delta{}, applied: map[primitive.ObjectID]int64{}}
+}
+
+func (s *store) flush(ctx context.Context, seq int64, deltas map[primitive.ObjectID]Delta) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.flushes = append(s.flushes, seq)
+	for id, delta := range deltas {
+		if s.applied[id] >= seq {
+			continue
+		}
+		s.counts[id] = s.counts[id].add(delta)
+		s.applied[id] = seq
+	}
+
+	if s.fail > 0 {
+		s.fail--
+		return errors.New("connection reset")
+	}
+	return nil
+}
+
+func vote(t *testing.T, b *Buffer, id primitive.ObjectID, delta Delta) {
+	t.Helper()
+	if _, err := b.Add(id, Delta{Likes: 100, Dislikes: 100}, 0, delta); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func segments(t *testing.T, dir string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	return names
+}
+
+func TestReplayAfterTornRecord(t *testing.T) {
+	dir := t.TempDir()
+	failing := func(ctx context.Context, seq int64, deltas map[primitive.ObjectID]Delta) error {
+		return errors.New("database is down")
+	}
+
+	b, err := Open(dir, failing, 0, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vote(t, b, cryptoA, Delta{Likes: 1})
+	vote(t, b, cryptoA, Delta{Likes: 1})
+	vote(t, b, cryptoB, Delta{Dislikes: 1})
+	if err := b.flush(context.Background()); err == nil {
+		t.Fatal("flush succeeded with a failing store")
+	}
+	vote(t, b, cryptoB, Delta{Likes: 1})
+	vote(t, b, cryptoA, Delta{Dislikes: 1})
+	if err := b.Close(context.Background()); err == nil {
+		t.Fatal("close flushed with a failing store")
+	}
+
+	names := segments(t, dir)
+	if len(names) != 2 {
+		t.Fatalf("left segments %v, want 2", names)
+	}
+
+	// a crash in the middle of the last write tears its record
+	last := dir + "/" + names[1]
+	info, err := os.Stat(last)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Truncate(last, info.Size()-5); err != nil {
+		t.Fatal(err)
+	}
+
+	s := newStore()
+	b, err = Open(dir, s.flush, 0, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := b.Pending(cryptoA, 0); got != (Delta{Likes: 2}) {
+		t.Fatalf("replayed %+v for A, want 2 likes and the torn dislike dropped", got)
+	}
+	if got := b.Pending(cryptoB, 0); got != (Delta{Likes: 1, Dislikes: 1}) {
+		t.Fatalf("replayed %+v for B, want 1 like and 1 dislike", got)
+	}
+
+	if err := b.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	want := map[primitive.ObjectID]Delta{cryptoA: {Likes: 2}, cryptoB: {Likes: 1, Dislikes: 1}}
+	if !reflect.DeepEqual(s.counts, want) {
+		t.Fatalf("flushed %+v, want %+v", s.counts, want)
+	}
+	if names := segments(t, dir); len(names) != 0 {
+		t.Fatalf("segments %v left after a successful flush", names)
+	}
+}
+
+func TestReflushIsNotCountedTwice(t *testing.T) {
+	dir := t.TempDir()
+	s := newStore()
+	s.fail = 2
+
+	b, err := Open(dir, s.flush, 0, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vote(t, b, cryptoA, Delta{Likes: 1})
+	vote(t, b, cryptoB, Delta{Dislikes: 1})
+
+	for i := 0; i < 2; i++ {
+		if err := b.flush(context.Background()); err == nil {
+			t.Fatalf("flush %d succeeded, want the lost reply to fail it", i+1)
+		}
+	}
+	vote(t, b, cryptoA, Delta{Likes: 1})
+	if err := b.flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(s.flushes) != 4 || s.flushes[0] != s.flushes[1] || s.flushes[1] != s.flushes[2] || s.flushes[3] <= s.flushes[2] {
+		t.Fatalf("flushed segments %v, want the first one three times then a newer one", s.flushes)
+	}
+	want := map[primitive.ObjectID]Delta{cryptoA: {Likes: 2}, cryptoB: {Dislikes: 1}}
+	if !reflect.DeepEqual(s.counts, want) {
+		t.Fatalf("counts %+v, want %+v", s.counts, want)
+	}
+
+	// after a crash the segment comes back under the same seq
+	vote(t, b, cryptoB, Delta{Dislikes: 1})
+	if err := b.rotate(); err != nil {
+		t.Fatal(err)
+	}
+	pending := b.rotated[0]
+	if err := s.flush(context.Background(), pending.Seq, pending.Deltas); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err = Open(dir, s.flush, 0, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.flushes[len(s.flushes)-1]; got != pending.Seq {
+		t.Fatalf("replayed segment %d, want %d", got, pending.Seq)
+	}
+	want[cryptoB] = Delta{Dislikes: 2}
+	if !reflect.DeepEqual(s.counts, want) {
+		t.Fatalf("counts %+v after the replay, want %+v", s.counts, want)
+	}
+}
+
+func TestAddNeverDropsBelowZero(t *testing.T) {
+	b, err := Open(t.TempDir(), newStore().flush, 0, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close(context.Background())
+
+	applied, err := b.Add(cryptoA, Delta{Likes: 1}, 0, Delta{Likes: -1})
+	if err != nil || applied != (Delta{Likes: -1}) {
+		t.Fatalf("applied %+v, %v, want one like removed", applied, err)
+	}
+	applied, err = b.Add(cryptoA, Delta{Likes: 1}, 0, Delta{Likes: -1})
+	if err != nil || applied != (Delta{}) {
+		t.Fatalf("applied %+v, %v, want nothing once likes reached zero", applied, err)
+	}
+}
@@ -0,0 +1,150 @@
+package votebuffer
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// A record is the crypto id, the like and dislike deltas, and a CRC32 of the
+// preceding bytes, so a record torn by a crash is detected on replay.
+const recordSize = 12 + 8 + 8 + 4
+
+// segment is one write-ahead log file and the deltas recorded in it. Votes
+// go to the open segment; rotated segments wait to be flushed, in Seq order.
+type segment struct {
+	Seq    int64
+	Deltas map[primitive.ObjectID]Delta
+
+	path    string
+	file    *os.File
+	records int
+	// adding counts votes written to the file but not yet in Deltas
+	adding sync.WaitGroup
+
+	syncMu  sync.Mutex
+	written int64
+	synced  int64
+}
+
+func segmentPath(dir string, seq int64) string {
+	return filepath.Join(dir, strconv.FormatInt(seq, 10)+".wal")
+}
+
+func createSegment(dir string, seq int64) (*segment, error) {
+	path := segmentPath(dir, seq)
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &segment{Seq: seq, Deltas: map[primitive.ObjectID]Delta{}, path: path, file: file}, nil
+}
+
+// append writes a record. The caller must hold the buffer lock, which keeps
+// records whole and in order; durability comes from sync.
+func (s *segment) append(id primitive.ObjectID, delta Delta) (int64, error) {
+	var record [recordSize]byte
+	copy(record[:12], id[:])
+	binary.BigEndian.PutUint64(record[12:20], uint64(delta.Likes))
+	binary.BigEndian.PutUint64(record[20:28], uint64(delta.Dislikes))
+	binary.BigEndian.PutUint32(record[28:], crc32.ChecksumIEEE(record[:28]))
+
+	if _, err := s.file.Write(record[:]); err != nil {
+		return 0, err
+	}
+
+	s.records++
+	return atomic.AddInt64(&s.written, recordSize), nil
+}
+
+// sync makes the file durable up to offset. Callers waiting together share
+// one fsync: whoever gets the lock syncs everything written so far.
+func (s *segment) sync(offset int64) error {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+
+	if s.synced >= offset {
+		return nil
+	}
+	written := atomic.LoadInt64(&s.written)
+	if err := s.file.Sync(); err != nil {
+		return err
+	}
+
+	s.synced = written
+	return nil
+}
+
+// readSegments loads the segments left in dir by a previous run, oldest
+// first. A torn record ends its segment.
+func readSegments(dir string) ([]*segment, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var segments []*segment
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".wal") {
+			continue
+		}
+
+		seq, err := strconv.ParseInt(strings.TrimSuffix(name, ".wal"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected file %s in the vote log", name)
+		}
+
+		segment := &segment{Seq: seq, Deltas: map[primitive.ObjectID]Delta{}, path: filepath.Join(dir, name)}
+		if err := segment.replay(); err != nil {
+			return nil, err
+		}
+		segments = append(segments, segment)
+	}
+
+	sort.Slice(segments, func(i, j int) bool { return segments[i].Seq < segments[j].Seq })
+	return segments, nil
+}
+
+func (s *segment) replay() error {
+	file, err := os.Open(s.path)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	var record [recordSize]byte
+	for {
+		_, err := io.ReadFull(file, record[:])
+		if err == io.EOF || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if crc32.ChecksumIEEE(record[:28]) != binary.BigEndian.Uint32(record[28:]) {
+			return nil
+		}
+
+		var id primitive.ObjectID
+		copy(id[:], record[:12])
+		s.Deltas[id] = s.Deltas[id].add(Delta{
+			Likes:    int64(binary.BigEndian.Uint64(record[12:20])),
+			Dislikes: int64(binary.BigEndian.Uint64(record[20:28])),
+		})
+		s.records++
+	}
+}
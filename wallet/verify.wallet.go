@@ -0,0 +1,126 @@
+package wallet
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"golang.org/x/crypto/sha3"
+)
+
+type Scheme int
+
+const (
+	Ed25519 Scheme = iota + 1
+	Secp256k1
+)
+
+var ErrInvalidSignature = errors.New("the signature does not match the vote and public key")
+
+// VoteMessage is the text a wallet signs to cast a vote. Every field is on
+// its own line so no value can be mistaken for another.
+func VoteMessage(cryptoId, direction, nonce string, timestamp int64) []byte {
+	return []byte("Vote on klever-challenge\n" +
+		"crypto: " + cryptoId + "\n" +
+		"direction: " + direction + "\n" +
+		"nonce: " + nonce + "\n" +
+		"timestamp: " + strconv.FormatInt(timestamp, 10))
+}
+
+// Verify checks that signature signs message with publicKey and returns the
+// wallet address of the key. It returns ErrInvalidSignature when the
+// signature does not match, and otherwise an error describing what is wrong
+// with the public key.
+//
+// Ed25519 signatures cover the message itself, as Solana wallets sign it,
+// and the address is the base58 public key. secp256k1 signatures are 64 byte
+// r‖s (a trailing recovery byte is ignored) with a low s, over the EIP-191
+// personal message hash, as Ethereum wallets sign it, and the address is the
+// 0x-prefixed last 20 bytes of the Keccak-256 hash of the key.
+func Verify(scheme Scheme, publicKey, message, signature []byte) (string, error) {
+	switch scheme {
+	case Ed25519:
+		if len(publicKey) != ed25519.PublicKeySize {
+			return "", fmt.Errorf("must be %d bytes for ed25519", ed25519.PublicKeySize)
+		}
+		if len(signature) != ed25519.SignatureSize || !ed25519.Verify(publicKey, message, signature) {
+			return "", ErrInvalidSignature
+		}
+		return base58(publicKey), nil
+
+	case Secp256k1:
+		if len(publicKey) != secp256k1.PubKeyBytesLenCompressed && len(publicKey) != secp256k1.PubKeyBytesLenUncompressed {
+			return "", errors.New("must be 33 or 65 bytes for secp256k1")
+		}
+		key, err := secp256k1.ParsePubKey(publicKey)
+		if err != nil {
+			return "", errors.New("is not a point on secp256k1")
+		}
+		if len(signature) != 64 && len(signature) != 65 {
+			return "", ErrInvalidSignature
+		}
+
+		// r and s must be in [1, n-1], and s in the lower half so the
+		// signature cannot be malleated into a second valid one
+		var r, s secp256k1.ModNScalar
+		if r.SetByteSlice(signature[:32]) || r.IsZero() || s.SetByteSlice(signature[32:64]) || s.IsZero() || s.IsOverHalfOrder() {
+			return "", ErrInvalidSignature
+		}
+		if !ecdsa.NewSignature(&r, &s).Verify(personalHash(message), key) {
+			return "", ErrInvalidSignature
+		}
+		return ethereumAddress(key), nil
+	}
+
+	return "", errors.New("uses an unsupported signature scheme")
+}
+
+func keccak256(data ...[]byte) []byte {
+	hash := sha3.NewLegacyKeccak256()
+	for _, part := range data {
+		hash.Write(part)
+	}
+
+	return hash.Sum(nil)
+}
+
+// personalHash is the EIP-191 hash behind Ethereum's personal_sign.
+func personalHash(message []byte) []byte {
+	prefix := "\x19Ethereum Signed Message:\n" + strconv.Itoa(len(message))
+	return keccak256([]byte(prefix), message)
+}
+
+func ethereumAddress(key *secp256k1.PublicKey) string {
+	return "0x" + hex.EncodeToString(keccak256(key.SerializeUncompressed()[1:])[12:])
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+func base58(data []byte) string {
+	number := new(big.Int).SetBytes(data)
+	radix := big.NewInt(58)
+	remainder := new(big.Int)
+
+	var encoded []byte
+	for number.Sign() > 0 {
+		number.DivMod(number, radix, remainder)
+		encoded = append(encoded, base58Alphabet[remainder.Int64()])
+	}
+	// every leading zero byte is written as a leading "1"
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+		encoded = append(encoded, base58Alphabet[0])
+	}
+
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+	return string(encoded)
+}
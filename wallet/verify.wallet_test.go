@@ -0,0 +1,168 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func decode(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// voteMessage is the message behind the signature vectors below.
+var voteMessage = VoteMessage("65f000000000000000000001", "LIKE", "6f1c2a", 1760000000)
+
+// Ethereum accounts signing voteMessage with personal_sign: the private key 1
+// and the first two Hardhat development accounts, whose addresses are well
+// known. Signatures are r‖s with s in the lower half of the order.
+var ethereumVectors = []struct {
+	name         string
+	uncompressed string
+	compressed   string
+	address      string
+	signature    string
+}{
+	{
+		name:         "private key 1",
+		uncompressed: "0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8",
+		compressed:   "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+		address:      "0x7e5f4552091a69125d5dfcb7b8c2659029395bdf",
+		signature:    "05850f26a8e795f74b54ed33ccd4bc393afb5c9a46323cbf112e631876d05b3b6ddeace705c96655ce7179ecf83f814dd00a6b40429f163ee98506de1bf67178",
+	},
+	{
+		name:         "hardhat account 0",
+		uncompressed: "048318535b54105d4a7aae60c08fc45f9687181b4fdfc625bd1a753fa7397fed753547f11ca8696646f2f3acb08e31016afac23e630c5d11f59f61fef57b0d2aa5",
+		compressed:   "038318535b54105d4a7aae60c08fc45f9687181b4fdfc625bd1a753fa7397fed75",
+		address:      "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266",
+		signature:    "01186b317db8039a5f6d951005be709fe70ee053174d930a6b92113c65bf96264a44e39865a10cb4d694dc02b7f3ee448b3d61a2bc7e48f660156af37c11566c",
+	},
+	{
+		name:         "hardhat account 1",
+		uncompressed: "04ba5734d8f7091719471e7f7ed6b9df170dc70cc661ca05e688601ad984f068b0d67351e5f06073092499336ab0839ef8a521afd334e53807205fa2f08eec74f4",
+		compressed:   "02ba5734d8f7091719471e7f7ed6b9df170dc70cc661ca05e688601ad984f068b0",
+		address:      "0x70997970c51812dc3a010c7d01b50e0d17dc79c8",
+		signature:    "be8afe56f9fe5df7acc6fa70baf1c157f7f45c9af7c3c25dc4c907cf955b1ed46f49bb0309a8b37399162316b219703cd9afdda01507eac5fe0c6aa6b2857663",
+	},
+}
+
+func TestKeccak256(t *testing.T) {
+	if got := hex.EncodeToString(keccak256()); got != "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470" {
+		t.Fatalf("keccak256 of nothing is %s", got)
+	}
+}
+
+func TestVerifySecp256k1(t *testing.T) {
+	for _, v := range ethereumVectors {
+		signature := decode(t, v.signature)
+		for _, key := range []string{v.uncompressed, v.compressed} {
+			address, err := Verify(Secp256k1, decode(t, key), voteMessage, signature)
+			if err != nil {
+				t.Fatalf("%s: %v", v.name, err)
+			}
+			if address != v.address {
+				t.Fatalf("%s: address %s, want %s", v.name, address, v.address)
+			}
+		}
+
+		// wallets append the recovery id
+		if _, err := Verify(Secp256k1, decode(t, v.compressed), voteMessage, append(signature, 0x1b)); err != nil {
+			t.Fatalf("%s with a recovery byte: %v", v.name, err)
+		}
+	}
+}
+
+func TestVerifySecp256k1Rejects(t *testing.T) {
+	v := ethereumVectors[1]
+	key := decode(t, v.compressed)
+	signature := decode(t, v.signature)
+	other := append([]byte(nil), voteMessage...)
+	other[len(other)-1] ^= 1
+
+	// n - s: the same signature with the high s a malleator would produce
+	highS := append(append([]byte(nil), signature[:32]...), decode(t, "b5bb1c679a5ef34b296b23fd480c11ba2f717b43f2ca57455fbcf3995424ead5")...)
+	zeroR := append(make([]byte, 32), signature[32:]...)
+	overflowS := append(append([]byte(nil), signature[:32]...), decode(t, "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141")...)
+
+	tests := []struct {
+		name      string
+		key       []byte
+		message   []byte
+		signature []byte
+	}{
+		{"other message", key, other, signature},
+		{"other key", decode(t, ethereumVectors[2].compressed), voteMessage, signature},
+		{"high s", key, voteMessage, highS},
+		{"zero r", key, voteMessage, zeroR},
+		{"s not below n", key, voteMessage, overflowS},
+		{"short signature", key, voteMessage, signature[:63]},
+	}
+	for _, test := range tests {
+		if _, err := Verify(Secp256k1, test.key, test.message, test.signature); !errors.Is(err, ErrInvalidSignature) {
+			t.Errorf("%s: got %v, want ErrInvalidSignature", test.name, err)
+		}
+	}
+
+	notOnCurve := decode(t, v.compressed)
+	notOnCurve[0] = 0x05
+	for name, key := range map[string][]byte{"wrong length": key[:32], "bad prefix": notOnCurve} {
+		if _, err := Verify(Secp256k1, key, voteMessage, signature); err == nil || errors.Is(err, ErrInvalidSignature) {
+			t.Errorf("%s: got %v, want a public key error", name, err)
+		}
+	}
+}
+
+func TestVerifyEd25519(t *testing.T) {
+	// RFC 8032, section 7.1, test 2
+	rfcKey := decode(t, "3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c")
+	rfcSignature := decode(t, "92a009a9f0d4cab8720e820b5f642540a2b27b5416503f8fb3762223ebdb69da085ac1e43e15996e458f3613d0f11d8c387b2eaeb4302aeeb00d291612bb0c00")
+	address, err := Verify(Ed25519, rfcKey, []byte{0x72}, rfcSignature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address != "586Z7H2vpX9qNhN2T4e9Utugie3ogjbxzGaMtM3E6HR5" {
+		t.Fatalf("address %s", address)
+	}
+
+	// the key of RFC 8032 test 1 signing the vote, as a Solana wallet's
+	// signMessage does
+	key := decode(t, "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a")
+	signature := decode(t, "6aec025ce187965c695682f695b478d30c05109dcbbc97ba983cff0a660c25167596da3bda542623fb2eacd8ae5010fb980a78b3653f2c25169dced139bb2201")
+	address, err = Verify(Ed25519, key, voteMessage, signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address != "FVen3X669xLzsi6N2V91DoiyzHzg1uAgqiT8jZ9nS96Z" {
+		t.Fatalf("address %s", address)
+	}
+
+	if _, err := Verify(Ed25519, key, []byte{0x72}, signature); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("other message: got %v, want ErrInvalidSignature", err)
+	}
+	if _, err := Verify(Ed25519, rfcKey, voteMessage, signature); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("other key: got %v, want ErrInvalidSignature", err)
+	}
+	if _, err := Verify(Ed25519, key[:31], voteMessage, signature); err == nil || errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("short key: got %v, want a public key error", err)
+	}
+}
+
+func TestBase58(t *testing.T) {
+	tests := map[string]string{
+		// the Solana system program and token program ids
+		"0000000000000000000000000000000000000000000000000000000000000000": "11111111111111111111111111111111",
+		"06ddf6e1d765a193d9cbe146ceeb79ac1cb485ed5f5b37913a8cf5857eff00a9": "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",
+		"0001": "12",
+		"":     "",
+	}
+	for input, want := range tests {
+		if got := base58(decode(t, input)); got != want {
+			t.Errorf("base58(%s) = %s, want %s", input, got, want)
+		}
+	}
+}
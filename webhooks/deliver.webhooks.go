@@ -0,0 +1,177 @@
+package webhooks
+
+import (
+	"api/models"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	baseBackoff = 5 * time.Second
+	maxBackoff  = time.Hour
+)
+
+// Backoff returns the delay before retrying a delivery that failed attempts
+// times: doubling from five seconds up to an hour, with up to 20% jitter so
+// failed deliveries do not retry in lockstep.
+func Backoff(attempts int64) time.Duration {
+	delay := maxBackoff
+	if attempts < 20 {
+		if d := baseBackoff << uint(attempts-1); d < maxBackoff {
+			delay = d
+		}
+	}
+
+	return delay + time.Duration(rand.Int63n(int64(delay)/5+1))
+}
+
+// Worker sends pending deliveries. Several workers, in one or many
+// processes, can share the deliveries collection: each delivery is leased
+// to a single worker while it is being sent.
+type Worker struct {
+	Hooks        *mongo.Collection
+	Deliveries   *mongo.Collection
+	Client       *http.Client
+	MaxAttempts  int64
+	PollInterval time.Duration
+}
+
+func (w *Worker) lease() time.Duration {
+	if w.Client != nil && w.Client.Timeout > 0 {
+		return 2 * w.Client.Timeout
+	}
+
+	return time.Minute
+}
+
+// claim leases the next delivery that is due, if any.
+func (w *Worker) claim(ctx context.Context) (*models.WebhookDelivery, error) {
+	now := time.Now()
+	filter := bson.M{
+		"status":        models.DeliveryPending,
+		"nextAttemptAt": bson.M{"$lte": now},
+		"$or":           bson.A{bson.M{"lockedUntil": nil}, bson.M{"lockedUntil": bson.M{"$lt": now}}},
+	}
+	update := bson.M{"$set": bson.M{"lockedUntil": now.Add(w.lease())}}
+	opts := options.FindOneAndUpdate().SetSort(bson.M{"nextAttemptAt": 1}).SetReturnDocument(options.After)
+
+	var delivery models.WebhookDelivery
+	err := w.Deliveries.FindOneAndUpdate(ctx, filter, update, opts).Decode(&delivery)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+// send posts the signed payload and returns the response status.
+func (w *Worker) send(ctx context.Context, hook models.Webhook, delivery models.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	timestamp := time.Now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, delivery.Event)
+	req.Header.Set(DeliveryHeader, delivery.Id.Hex())
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(hook.Secret, timestamp, body))
+
+	client := w.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("endpoint answered %s", resp.Status)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// deliver sends a claimed delivery and records the outcome: delivered,
+// scheduled for a retry, or dead once MaxAttempts is reached.
+func (w *Worker) deliver(ctx context.Context, delivery models.WebhookDelivery) error {
+	var hook models.Webhook
+	err := w.Hooks.FindOne(ctx, bson.M{"_id": delivery.WebhookId}).Decode(&hook)
+
+	var status int
+	giveUp := delivery.Attempts+1 >= w.MaxAttempts
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		err, giveUp = errors.New("webhook was deleted"), true
+	case err != nil:
+		return err
+	default:
+		status, err = w.send(ctx, hook, delivery)
+	}
+
+	now := time.Now()
+	set := bson.M{"attempts": delivery.Attempts + 1, "lastStatus": status}
+	switch {
+	case err == nil:
+		set["status"] = models.DeliveryDelivered
+		set["deliveredAt"] = now
+		set["lastError"] = ""
+	case giveUp:
+		set["status"] = models.DeliveryDead
+		set["lastError"] = err.Error()
+	default:
+		set["nextAttemptAt"] = now.Add(Backoff(delivery.Attempts + 1))
+		set["lastError"] = err.Error()
+	}
+
+	_, err = w.Deliveries.UpdateOne(ctx, bson.M{"_id": delivery.Id}, bson.M{"$set": set, "$unset": bson.M{"lockedUntil": ""}})
+	return err
+}
+
+// Run sends due deliveries every PollInterval until ctx is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for ctx.Err() == nil {
+			delivery, err := w.claim(ctx)
+			if err == nil && delivery != nil {
+				err = w.deliver(ctx, *delivery)
+			}
+			if err != nil && ctx.Err() == nil {
+				log.Printf("Could not process webhook deliveries: %v", err)
+			}
+			if err != nil || delivery == nil {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package webhooks
+
+import (
+	"api/models"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+const testSecret = "whsec_test"
+
+type request struct {
+	header http.Header
+	body   string
+}
+
+// endpoint answers with the given statuses in turn and records the requests.
+func endpoint(t *testing.T, statuses ...int) (*httptest.Server, *[]request) {
+	var requests []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, request{header: r.Header.Clone(), body: string(body)})
+		w.WriteHeader(statuses[(len(requests)-1)%len(statuses)])
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+// deliverOnce runs one delivery attempt against the mock deployment and
+// returns the $set of the update recording its outcome.
+func deliverOnce(mt *mtest.T, worker *Worker, hook models.Webhook, delivery models.WebhookDelivery) bson.Raw {
+	hookDoc, err := bson.Marshal(hook)
+	if err != nil {
+		mt.Fatal(err)
+	}
+	var found bson.D
+	if err := bson.Unmarshal(hookDoc, &found); err != nil {
+		mt.Fatal(err)
+	}
+	mt.AddMockResponses(
+		mtest.CreateCursorResponse(0, "test.webhooks", mtest.FirstBatch, found),
+		mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+	)
+
+	worker.Hooks = mt.DB.Collection("webhooks")
+	worker.Deliveries = mt.DB.Collection("webhook_deliveries")
+	if err := worker.deliver(context.Background(), delivery); err != nil {
+		mt.Fatal(err)
+	}
+
+	var update bson.Raw
+	for started := mt.GetStartedEvent(); started != nil; started = mt.GetStartedEvent() {
+		if started.CommandName == "update" {
+			update = started.Command
+		}
+	}
+	if update == nil {
+		mt.Fatal("the outcome was not recorded")
+	}
+
+	return update.Lookup("updates").Array().Index(0).Value().Document().Lookup("u", "$set").Document()
+}
+
+func TestWorker(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	newDelivery := func(attempts int64) models.WebhookDelivery {
+		return models.WebhookDelivery{
+			Id:       primitive.NewObjectID(),
+			Event:    "crypto.voted",
+			Payload:  `{"type":"crypto.voted","cryptoId":"65f000000000000000000001"}`,
+			Status:   models.DeliveryPending,
+			Attempts: attempts,
+		}
+	}
+
+	mt.Run("signs and delivers", func(mt *mtest.T) {
+		server, requests := endpoint(t, http.StatusNoContent)
+		hook := models.Webhook{Id: primitive.NewObjectID(), Url: server.URL, Secret: testSecret}
+		delivery := newDelivery(0)
+
+		set := deliverOnce(mt, &Worker{Client: server.Client(), MaxAttempts: 3}, hook, delivery)
+
+		if len(*requests) != 1 {
+			mt.Fatalf("sent %d requests, want 1", len(*requests))
+		}
+		sent := (*requests)[0]
+		if sent.body != delivery.Payload {
+			mt.Fatalf("sent body %q, want %q", sent.body, delivery.Payload)
+		}
+		if got := sent.header.Get(EventHeader); got != delivery.Event {
+			mt.Fatalf("event header %q, want %q", got, delivery.Event)
+		}
+		if got := sent.header.Get(DeliveryHeader); got != delivery.Id.Hex() {
+			mt.Fatalf("delivery header %q, want %q", got, delivery.Id.Hex())
+		}
+		timestamp, err := strconv.ParseInt(sent.header.Get(TimestampHeader), 10, 64)
+		if err != nil {
+			mt.Fatal(err)
+		}
+		if !Verify(testSecret, sent.header.Get(SignatureHeader), timestamp, []byte(sent.body)) {
+			mt.Fatalf("signature %q does not verify", sent.header.Get(SignatureHeader))
+		}
+		if Verify("other", sent.header.Get(SignatureHeader), timestamp, []byte(sent.body)) {
+			mt.Fatal("signature verifies with another secret")
+		}
+
+		if got := set.Lookup("status").StringValue(); got != models.DeliveryDelivered {
+			mt.Fatalf("status %q, want %q", got, models.DeliveryDelivered)
+		}
+		if got := set.Lookup("attempts").AsInt64(); got != 1 {
+			mt.Fatalf("attempts %d, want 1", got)
+		}
+	})
+
+	mt.Run("backs off after a 5xx", func(mt *mtest.T) {
+		server, _ := endpoint(t, http.StatusServiceUnavailable)
+		hook := models.Webhook{Id: primitive.NewObjectID(), Url: server.URL, Secret: testSecret}
+
+		for attempts := int64(0); attempts < 3; attempts++ {
+			before := time.Now()
+			set := deliverOnce(mt, &Worker{Client: server.Client(), MaxAttempts: 5}, hook, newDelivery(attempts))
+
+			if status, ok := set.Lookup("status").StringValueOK(); ok {
+				mt.Fatalf("attempt %d: status set to %q, want it left pending", attempts+1, status)
+			}
+			if got := set.Lookup("lastStatus").AsInt64(); got != http.StatusServiceUnavailable {
+				mt.Fatalf("attempt %d: lastStatus %d, want 503", attempts+1, got)
+			}
+
+			delay := set.Lookup("nextAttemptAt").Time().Sub(before)
+			base := baseBackoff << uint(attempts)
+			if delay < base-time.Second || delay > base+base/5+time.Second {
+				mt.Fatalf("attempt %d: retried after %s, want %s plus up to 20%%", attempts+1, delay, base)
+			}
+		}
+	})
+
+	mt.Run("dead after MaxAttempts", func(mt *mtest.T) {
+		server, requests := endpoint(t, http.StatusInternalServerError)
+		hook := models.Webhook{Id: primitive.NewObjectID(), Url: server.URL, Secret: testSecret}
+
+		set := deliverOnce(mt, &Worker{Client: server.Client(), MaxAttempts: 3}, hook, newDelivery(2))
+
+		if len(*requests) != 1 {
+			mt.Fatalf("sent %d requests, want 1", len(*requests))
+		}
+		if got := set.Lookup("status").StringValue(); got != models.DeliveryDead {
+			mt.Fatalf("status %q, want %q", got, models.DeliveryDead)
+		}
+		if got := set.Lookup("attempts").AsInt64(); got != 3 {
+			mt.Fatalf("attempts %d, want 3", got)
+		}
+		if _, ok := set.Lookup("nextAttemptAt").TimeOK(); ok {
+			mt.Fatal("a dead delivery was scheduled again")
+		}
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	for attempts := int64(1); attempts <= 25; attempts++ {
+		base := maxBackoff
+		if attempts < 20 && baseBackoff<<uint(attempts-1) < maxBackoff {
+			base = baseBackoff << uint(attempts-1)
+		}
+
+		for i := 0; i < 100; i++ {
+			if delay := Backoff(attempts); delay < base || delay > base+base/5 {
+				t.Fatalf("Backoff(%d) = %s, want between %s and %s", attempts, delay, base, base+base/5)
+			}
+		}
+	}
+}
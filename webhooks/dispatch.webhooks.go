@@ -0,0 +1,231 @@
+package webhooks
+
+import (
+	"api/events"
+	"api/models"
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// VoteThreshold is delivered when a vote moves the voteRate of a crypto
+// across one of the thresholds of a webhook.
+const VoteThreshold = "crypto.vote_threshold"
+
+type payload struct {
+	Id            string             `json:"id"`
+	Type          string             `json:"type"`
+	OccurredAt    time.Time          `json:"occurredAt"`
+	CryptoId      string             `json:"cryptoId,omitempty"`
+	Crypto        *models.CryptoItem `json:"crypto,omitempty"`
+	LikesDelta    int64              `json:"likesDelta,omitempty"`
+	DislikesDelta int64              `json:"dislikesDelta,omitempty"`
+	Threshold     *int64             `json:"threshold,omitempty"`
+}
+
+// crossed returns the thresholds a voteRate change from before to after went
+// across: reached from below, or left by falling under it.
+func crossed(thresholds []int64, before, after int64) []int64 {
+	var result []int64
+	for _, threshold := range thresholds {
+		if (before < threshold) != (after < threshold) {
+			result = append(result, threshold)
+		}
+	}
+
+	return result
+}
+
+func watches(hook models.Webhook, cryptoId string) bool {
+	if len(hook.CryptoIds) == 0 {
+		return true
+	}
+	for _, id := range hook.CryptoIds {
+		if id == cryptoId {
+			return true
+		}
+	}
+
+	return false
+}
+
+func subscribed(hook models.Webhook, eventType string) bool {
+	for _, subscribed := range hook.Events {
+		if subscribed == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newDelivery(hook models.Webhook, event events.Event, source, eventType string, threshold *int64) (models.WebhookDelivery, error) {
+	if source != "" {
+		source += ":" + hook.Id.Hex() + ":" + eventType
+		if threshold != nil {
+			source += ":" + strconv.FormatInt(*threshold, 10)
+		}
+	}
+
+	delivery := models.WebhookDelivery{
+		Source:        source,
+		Id:            primitive.NewObjectID(),
+		WebhookId:     hook.Id,
+		Event:         eventType,
+		Status:        models.DeliveryPending,
+		NextAttemptAt: time.Now(),
+		CreatedAt:     time.Now(),
+	}
+
+	body := payload{
+		Id:         delivery.Id.Hex(),
+		Type:       eventType,
+		OccurredAt: event.OccurredAt,
+		CryptoId:   event.CryptoId,
+		Threshold:  threshold,
+	}
+	if event.Type != events.Deleted && event.Type != events.Purged && event.Type != events.Imported {
+		crypto := event.Crypto
+		body.Crypto = &crypto
+	}
+	if event.Type == events.Voted {
+		body.LikesDelta, body.DislikesDelta = event.LikesDelta, event.DislikesDelta
+	}
+
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return delivery, err
+	}
+	delivery.Payload = string(raw)
+
+	return delivery, nil
+}
+
+// deliveriesFor builds the deliveries an event causes, one per subscribed
+// webhook and one per threshold crossed. source, when set, names the outbox
+// message the event came from.
+func deliveriesFor(ctx context.Context, hooks *mongo.Collection, event events.Event, source string) ([]interface{}, error) {
+	filter := bson.M{"events": string(event.Type)}
+	if event.Type == events.Voted {
+		filter = bson.M{"$or": bson.A{filter, bson.M{"voteRateThresholds.0": bson.M{"$exists": true}}}}
+	}
+
+	cursor, err := hooks.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cursor.Close(ctx)
+
+	before := event.Crypto.VoteRate - (event.LikesDelta - event.DislikesDelta)
+
+	var deliveries []interface{}
+	for cursor.Next(ctx) {
+		var hook models.Webhook
+		if err := cursor.Decode(&hook); err != nil {
+			return nil, err
+		}
+		if !watches(hook, event.CryptoId) {
+			continue
+		}
+
+		if subscribed(hook, string(event.Type)) {
+			delivery, err := newDelivery(hook, event, source, string(event.Type), nil)
+			if err != nil {
+				return nil, err
+			}
+			deliveries = append(deliveries, delivery)
+		}
+
+		if event.Type != events.Voted {
+			continue
+		}
+		for _, threshold := range crossed(hook.VoteRateThresholds, before, event.Crypto.VoteRate) {
+			threshold := threshold
+			delivery, err := newDelivery(hook, event, source, VoteThreshold, &threshold)
+			if err != nil {
+				return nil, err
+			}
+			deliveries = append(deliveries, delivery)
+		}
+	}
+
+	return deliveries, cursor.Err()
+}
+
+// Dispatch queues a pending delivery for every webhook subscribed to each
+// published event, until ctx is cancelled. The bus drops events when the
+// dispatcher falls behind and forgets those published before a crash, so
+// Publisher replaces it whenever the outbox is enabled.
+func Dispatch(ctx context.Context, hooks, deliveries *mongo.Collection, changes <-chan events.Event) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-changes:
+			queued, err := deliveriesFor(ctx, hooks, event, "")
+			if err == nil && len(queued) > 0 {
+				_, err = deliveries.InsertMany(ctx, queued)
+			}
+			if err != nil && ctx.Err() == nil {
+				log.Printf("Could not queue webhook deliveries for %s: %v", event.Type, err)
+			}
+		}
+	}
+}
+
+// Publisher is an outbox publisher queueing the deliveries of each relayed
+// event, so no committed change is missed. Deliveries already queued for a
+// message are skipped when the relay publishes it again.
+type Publisher struct {
+	Hooks      *mongo.Collection
+	Deliveries *mongo.Collection
+}
+
+func (p *Publisher) Publish(ctx context.Context, key string, payload []byte) error {
+	var message models.OutboxMessage
+	if err := json.Unmarshal(payload, &message); err != nil {
+		return err
+	}
+
+	event := events.Event{
+		Type:          events.Type(message.Type),
+		CryptoId:      message.CryptoId,
+		OccurredAt:    message.OccurredAt,
+		LikesDelta:    message.LikesDelta,
+		DislikesDelta: message.DislikesDelta,
+	}
+	if message.Crypto != nil {
+		event.Crypto = *message.Crypto
+	}
+
+	queued, err := deliveriesFor(ctx, p.Hooks, event, message.Id.Hex())
+	if err != nil || len(queued) == 0 {
+		return err
+	}
+
+	_, err = p.Deliveries.InsertMany(ctx, queued, options.InsertMany().SetOrdered(false))
+	var bulkErr mongo.BulkWriteException
+	if errors.As(err, &bulkErr) && bulkErr.WriteConcernError == nil {
+		for _, writeErr := range bulkErr.WriteErrors {
+			if !mongo.IsDuplicateKeyError(writeErr) {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return err
+}
+
+func (p *Publisher) Close() error {
+	return nil
+}